@@ -0,0 +1,168 @@
+// dpetheader 查看或修改dpet文件头，压缩后的数据区原样复制，不重新解压及压缩。
+//
+// 用法：
+//
+//	dpetheader file                                    以json格式输出文件头
+//	dpetheader -set acquisitionInfo.patientName=Foo file  修改字段，可多次指定-set
+//	dpetheader -header header.json file                以json文件替换文件头内容
+//	dpetheader -marshal json file                      修改文件头的序列化方式（proto或json）
+//
+// 字段路径使用protojson中的字段名，各级以'.'分隔。
+// 文件头中描述数据区的字段（传输语义、md5值及块索引）不能修改
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/louis296/pet/dpet"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type setFlags []string
+
+func (s *setFlags) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *setFlags) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+func main() {
+	var sets setFlags
+	flag.Var(&sets, "set", "修改字段，格式为path=value")
+	headerFile := flag.String("header", "", "以json文件替换文件头内容")
+	marshal := flag.String("marshal", "", "文件头序列化方式：proto或json")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	path := flag.Arg(0)
+
+	var err error
+	if len(sets) == 0 && *headerFile == "" && *marshal == "" {
+		err = show(path)
+	} else {
+		err = dpet.RewriteHeader(path, func(header *dpet.Header) error {
+			return edit(header, sets, *headerFile, *marshal)
+		})
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "dpetheader:", err)
+		os.Exit(1)
+	}
+}
+
+func show(path string) error {
+	dataset, err := dpet.ParseFile(path, dpet.OnlyParseHeader())
+	if err != nil {
+		return err
+	}
+	out, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(dataset.Header.Content)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+func edit(header *dpet.Header, sets []string, headerFile, marshal string) error {
+	if headerFile != "" {
+		bs, err := os.ReadFile(headerFile)
+		if err != nil {
+			return err
+		}
+		content := &dpet.PetFileHeader{}
+		if err = protojson.Unmarshal(bs, content); err != nil {
+			return err
+		}
+		header.Content = content
+	}
+	for _, set := range sets {
+		path, value, ok := strings.Cut(set, "=")
+		if !ok {
+			return fmt.Errorf("invalid -set %q, expected path=value", set)
+		}
+		if err := setField(header.Content.ProtoReflect(), strings.Split(path, "."), value); err != nil {
+			return fmt.Errorf("set %s: %w", path, err)
+		}
+	}
+	switch marshal {
+	case "":
+	case "proto":
+		header.MarshalMethod = dpet.MarshallMethodProto
+	case "json":
+		header.MarshalMethod = dpet.MarshallMethodJSON
+	default:
+		return fmt.Errorf("unknown marshal method %q", marshal)
+	}
+	return nil
+}
+
+// setField 按字段路径设置标量字段，路径中间的消息字段不存在时自动创建
+func setField(msg protoreflect.Message, path []string, value string) error {
+	fields := msg.Descriptor().Fields()
+	fd := fields.ByJSONName(path[0])
+	if fd == nil {
+		fd = fields.ByName(protoreflect.Name(path[0]))
+	}
+	if fd == nil {
+		return fmt.Errorf("unknown field %s", path[0])
+	}
+	if len(path) > 1 {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() {
+			return fmt.Errorf("field %s is not a message", path[0])
+		}
+		return setField(msg.Mutable(fd).Message(), path[1:], value)
+	}
+	if fd.IsList() || fd.IsMap() {
+		return fmt.Errorf("field %s is not a scalar", path[0])
+	}
+	v, err := parseValue(fd, value)
+	if err != nil {
+		return err
+	}
+	msg.Set(fd, v)
+	return nil
+}
+
+func parseValue(fd protoreflect.FieldDescriptor, value string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(value), nil
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(value)
+		return protoreflect.ValueOfBool(v), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(value, 10, 64)
+		return protoreflect.ValueOfInt64(v), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(value, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(v)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(value, 10, 64)
+		return protoreflect.ValueOfUint64(v), err
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(value, 32)
+		return protoreflect.ValueOfFloat32(float32(v)), err
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(value, 64)
+		return protoreflect.ValueOfFloat64(v), err
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(value)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		v, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), err
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s", fd.Kind())
+}
@@ -0,0 +1,236 @@
+// Package coin 由E180原始数据中的单事件进行符合处理，生成符合事件对
+package coin
+
+import (
+	"github.com/louis296/pet/dpet"
+	"google.golang.org/protobuf/proto"
+	"sort"
+)
+
+// MultiplesPolicy 时间窗内多于两个事件（多重符合）时的处理方式
+type MultiplesPolicy int
+
+const (
+	// DiscardMultiples 丢弃时间窗内的全部事件
+	DiscardMultiples MultiplesPolicy = iota
+	// TakeAllPairs 时间窗内的事件两两组成符合对
+	TakeAllPairs
+	// TakeHighestEnergy 取能量最高的两个事件组成符合对
+	TakeHighestEnergy
+)
+
+func (p MultiplesPolicy) String() string {
+	switch p {
+	case DiscardMultiples:
+		return "DiscardMultiples"
+	case TakeAllPairs:
+		return "TakeAllPairs"
+	case TakeHighestEnergy:
+		return "TakeHighestEnergy"
+	}
+	return "Unknown"
+}
+
+// Config 符合处理参数
+type Config struct {
+	// TimingWindow 符合时间窗，单位与单事件时间戳相同为ps，与时间窗起始事件的时间差不大于此值的事件视为同一时间窗。
+	// Convert将其换算为ns写入CoincidenceInfo.TimingWindow
+	TimingWindow float64
+	// EnergyWindowStart、EnergyWindowEnd 能量窗，能量在[start,end]之外的单事件不参与符合
+	EnergyWindowStart float32
+	EnergyWindowEnd   float32
+	Multiples         MultiplesPolicy
+
+	// Energy 单事件的能量，为nil时使用ADC能量值，可在此进行能量刻度
+	Energy func(single dpet.SingleE180) float32
+	// CrystalIndex 单事件的全局晶体编号，为nil时按ScannerInfo中的几何结构计算，见GeometryCrystalIndex
+	CrystalIndex func(single dpet.SingleE180) uint32
+
+	// Layout 数据包的位域参数，须按设备说明填写，见dpet.BDMLayout
+	Layout dpet.BDMLayout
+	// FailOnBadPacket 为true时遇到无法解码的数据包即返回错误，否则跳过该数据包并计入Stats.BadPackets
+	FailOnBadPacket bool
+}
+
+// Stats 符合处理的统计信息
+type Stats struct {
+	// 解码得到的单事件数
+	Singles int
+	// 无法解码而被跳过的数据包数
+	BadPackets int
+	// 输出的符合对数
+	Pairs int
+}
+
+// GeometryCrystalIndex 按ScannerInfo中的几何结构计算全局晶体编号：
+// DU对应模块，BDM对应模块内的block，block内按行（Y）优先排列晶体，未填写的数量按1计
+func GeometryCrystalIndex(scanner *dpet.ScannerInfo) func(single dpet.SingleE180) uint32 {
+	count := func(ns ...int32) uint32 {
+		res := uint32(1)
+		for _, n := range ns {
+			if n > 0 {
+				res *= uint32(n)
+			}
+		}
+		return res
+	}
+	blocks := count(scanner.GetBlockNumX(), scanner.GetBlockNumY(), scanner.GetBlockNumZ())
+	cols := count(scanner.GetCrystalNumX())
+	crystals := count(scanner.GetCrystalNumX(), scanner.GetCrystalNumY())
+	return func(single dpet.SingleE180) uint32 {
+		block := uint32(single.DU)*blocks + uint32(single.BDM)
+		return block*crystals + uint32(single.Y)*cols + uint32(single.X)
+	}
+}
+
+// event 参与符合的单事件
+type event struct {
+	crystal uint32
+	energy  float32
+	time    uint64
+}
+
+// Sorter 符合处理器
+type Sorter struct {
+	config Config
+}
+
+// NewSorter 按config创建符合处理器，Energy及CrystalIndex为nil时使用scanner计算
+func NewSorter(scanner *dpet.ScannerInfo, config Config) *Sorter {
+	if config.Energy == nil {
+		config.Energy = func(single dpet.SingleE180) float32 {
+			return float32(single.Energy)
+		}
+	}
+	if config.CrystalIndex == nil {
+		config.CrystalIndex = GeometryCrystalIndex(scanner)
+	}
+	return &Sorter{config: config}
+}
+
+// Sort 解码原始数据中的单事件，经能量窗筛选并按时间排序后进行符合处理，符合对中时间较早的事件在前。
+// 单个数据包损坏不影响其余数据，默认跳过并计数，FailOnBadPacket时返回错误；Layout不合法时直接返回错误
+func (s *Sorter) Sort(raw *dpet.RawDataE180) (*dpet.ListModeCoinDataE180, Stats, error) {
+	var stats Stats
+	if err := s.config.Layout.Validate(); err != nil {
+		return nil, stats, err
+	}
+	var events []event
+	for _, info := range raw.BDMInfos {
+		for _, body := range info.Content {
+			single, err := s.config.Layout.Decode(body)
+			if err != nil {
+				if s.config.FailOnBadPacket {
+					return nil, stats, err
+				}
+				stats.BadPackets++
+				continue
+			}
+			stats.Singles++
+			energy := s.config.Energy(single)
+			if energy < s.config.EnergyWindowStart || energy > s.config.EnergyWindowEnd {
+				continue
+			}
+			events = append(events, event{
+				crystal: s.config.CrystalIndex(single),
+				energy:  energy,
+				time:    single.Time,
+			})
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].time < events[j].time
+	})
+
+	res := &dpet.ListModeCoinDataE180{}
+	for i := 0; i < len(events); {
+		// 时间窗由首个事件开启，窗内事件不再开启新的时间窗
+		j := i + 1
+		for j < len(events) && float64(events[j].time-events[i].time) <= s.config.TimingWindow {
+			j++
+		}
+		res.CoinPairs = append(res.CoinPairs, s.pairs(events[i:j])...)
+		i = j
+	}
+	stats.Pairs = len(res.CoinPairs)
+	return res, stats, nil
+}
+
+// pairs 按多重符合处理方式将时间窗内的事件组成符合对
+func (s *Sorter) pairs(window []event) []dpet.CoinPair {
+	switch {
+	case len(window) < 2:
+		return nil
+	case len(window) == 2:
+		return []dpet.CoinPair{newPair(window[0], window[1])}
+	}
+	switch s.config.Multiples {
+	case TakeAllPairs:
+		var res []dpet.CoinPair
+		for i := range window {
+			for j := i + 1; j < len(window); j++ {
+				res = append(res, newPair(window[i], window[j]))
+			}
+		}
+		return res
+	case TakeHighestEnergy:
+		// 选出能量最高的两个事件，能量相同时取时间较早者
+		first, second := 0, 1
+		if window[second].energy > window[first].energy {
+			first, second = second, first
+		}
+		for i := 2; i < len(window); i++ {
+			if window[i].energy > window[first].energy {
+				first, second = i, first
+			} else if window[i].energy > window[second].energy {
+				second = i
+			}
+		}
+		if first > second {
+			first, second = second, first
+		}
+		return []dpet.CoinPair{newPair(window[first], window[second])}
+	}
+	return nil
+}
+
+func newPair(a, b event) dpet.CoinPair {
+	return dpet.CoinPair{
+		{GlobalCrystalIndex: a.crystal, Energy: a.energy, TimeValue: float64(a.time)},
+		{GlobalCrystalIndex: b.crystal, Energy: b.energy, TimeValue: float64(b.time)},
+	}
+}
+
+// Convert 对E180原始数据dataset进行符合处理，返回符合信息dataset及统计信息。
+// 文件头复制自原始数据，符合处理参数记录在CoincidenceInfo中
+func Convert(dataset *dpet.Dataset, config Config) (*dpet.Dataset, Stats, error) {
+	raw, err := dataset.RawDataE180()
+	if err != nil {
+		return nil, Stats{}, err
+	}
+	// 缺少PublicInfo时文件类型无从确认
+	if dataset.Header.Content.GetPublicInfo() == nil {
+		return nil, Stats{}, dpet.DataTypeMismatch
+	}
+	content := proto.Clone(dataset.Header.Content).(*dpet.PetFileHeader)
+	data, stats, err := NewSorter(content.ScannerInfo, config).Sort(raw)
+	if err != nil {
+		return nil, stats, err
+	}
+
+	content.PublicInfo.FileType = dpet.FileType_ListModeCoin
+	content.PublicInfo.MD5 = ""
+	content.DataBlocks = nil
+	if content.CoincidenceInfo == nil {
+		content.CoincidenceInfo = &dpet.CoincidenceInfo{}
+	}
+	content.CoincidenceInfo.Device = content.GetScannerInfo().GetDevice()
+	content.CoincidenceInfo.TimingWindow = float32(config.TimingWindow / 1000)
+	content.CoincidenceInfo.EnergyWindowsStart = config.EnergyWindowStart
+	content.CoincidenceInfo.EnergyWindowEnd = config.EnergyWindowEnd
+	content.CoincidenceInfo.MergingAlgorithm = config.Multiples.String()
+	return &dpet.Dataset{
+		Header: &dpet.Header{MarshalMethod: dataset.Header.MarshalMethod, Content: content},
+		Data:   data,
+	}, stats, nil
+}
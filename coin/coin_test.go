@@ -0,0 +1,143 @@
+package coin
+
+import (
+	"bytes"
+	"errors"
+	"github.com/louis296/pet/dpet"
+	"testing"
+)
+
+// testLayout 测试用的位域参数，仅用于编解码往返，不代表设备的实际取值
+var testLayout = dpet.BDMLayout{Head: 0xa, Tail: 0x5, CoarsePeriod: 5000}
+
+func testRawData(t *testing.T, singles []dpet.SingleE180) *dpet.RawDataE180 {
+	info := &dpet.BDMInfo{DataLen: uint32(len(singles) * dpet.BDMInfoBodyByteLen)}
+	for _, single := range singles {
+		body, err := testLayout.Encode(single)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info.Content = append(info.Content, body)
+	}
+	return &dpet.RawDataE180{BDMInfos: []*dpet.BDMInfo{info}}
+}
+
+func TestSorter(t *testing.T) {
+	singles := []dpet.SingleE180{
+		// 乱序的两个事件组成一对
+		{X: 1, Time: 100, Energy: 500},
+		{X: 0, Time: 0, Energy: 510},
+		// 单独的事件
+		{X: 2, Time: 10000, Energy: 511},
+		// 三重符合
+		{X: 3, Time: 20000, Energy: 400},
+		{X: 4, Time: 20100, Energy: 520},
+		{X: 5, Time: 20200, Energy: 530},
+		// 能量窗外
+		{X: 6, Time: 30000, Energy: 100},
+		{X: 7, Time: 30100, Energy: 500},
+	}
+	raw := testRawData(t, singles)
+	scanner := &dpet.ScannerInfo{CrystalNumX: 8, CrystalNumY: 8}
+	config := Config{TimingWindow: 500, EnergyWindowStart: 350, EnergyWindowEnd: 650, Layout: testLayout}
+
+	for _, c := range []struct {
+		policy MultiplesPolicy
+		pairs  [][2]uint32
+	}{
+		{DiscardMultiples, [][2]uint32{{0, 1}}},
+		{TakeAllPairs, [][2]uint32{{0, 1}, {3, 4}, {3, 5}, {4, 5}}},
+		{TakeHighestEnergy, [][2]uint32{{0, 1}, {4, 5}}},
+	} {
+		config.Multiples = c.policy
+		res, stats, err := NewSorter(scanner, config).Sort(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stats.Singles != len(singles) || stats.Pairs != len(c.pairs) || len(res.CoinPairs) != len(c.pairs) {
+			t.Fatalf("%v: unexpected pairs: %d", c.policy, len(res.CoinPairs))
+		}
+		for i, pair := range res.CoinPairs {
+			if pair[0].GlobalCrystalIndex != c.pairs[i][0] || pair[1].GlobalCrystalIndex != c.pairs[i][1] {
+				t.Fatalf("%v: unexpected pair %d: %v %v", c.policy, i, pair[0], pair[1])
+			}
+		}
+	}
+
+	// DU及BDM参与全局晶体编号
+	index := GeometryCrystalIndex(&dpet.ScannerInfo{BlockNumX: 4, CrystalNumX: 8, CrystalNumY: 8})
+	if i := index(dpet.SingleE180{DU: 1, BDM: 2, X: 3, Y: 4}); i != (1*4+2)*64+4*8+3 {
+		t.Fatalf("unexpected crystal index: %d", i)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	header := &dpet.Header{Content: &dpet.PetFileHeader{
+		PublicInfo:  &dpet.PublicInfo{FileType: dpet.FileType_RawData},
+		ScannerInfo: &dpet.ScannerInfo{Device: dpet.FileE180, CrystalNumX: 8, CrystalNumY: 8},
+	}}
+	raw := testRawData(t, []dpet.SingleE180{{X: 0, Time: 0, Energy: 511}, {X: 1, Time: 10, Energy: 511}})
+	config := Config{TimingWindow: 4000, EnergyWindowStart: 350, EnergyWindowEnd: 650, Multiples: TakeAllPairs, Layout: testLayout}
+	coin, _, err := Convert(&dpet.Dataset{Header: header, Data: raw}, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := coin.Header.Content.CoincidenceInfo
+	if info.TimingWindow != 4 || info.EnergyWindowsStart != 350 || info.EnergyWindowEnd != 650 || info.MergingAlgorithm != "TakeAllPairs" {
+		t.Fatalf("unexpected coincidence info: %v", info)
+	}
+	buf := bytes.NewBuffer(nil)
+	if err = dpet.Write(coin, buf, dpet.WriteVerified()); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := dpet.Parse(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pairs, err := parsed.CoinPairsE180()
+	if err != nil || len(pairs) != 1 || pairs[0][1].TimeValue != 10 {
+		t.Fatalf("unexpected pairs: %v %v", pairs, err)
+	}
+	if header.Content.PublicInfo.FileType != dpet.FileType_RawData {
+		t.Fatal("Convert should not modify input header")
+	}
+	header.Content.PublicInfo = nil
+	if _, _, err = Convert(&dpet.Dataset{Header: header, Data: raw}, config); err != dpet.DataTypeMismatch {
+		t.Fatalf("expected DataTypeMismatch, got %v", err)
+	}
+}
+
+func TestSorterBadPacket(t *testing.T) {
+	raw := testRawData(t, []dpet.SingleE180{{X: 0, Time: 0, Energy: 511}, {X: 1, Time: 10, Energy: 511}})
+	// 时间字段长度错误的数据包
+	info := raw.BDMInfos[0]
+	info.Content = append([]*dpet.BDMInfoBody{{Time: []uint8{1, 2}, Energy: []uint8{3, 4}}}, info.Content...)
+	scanner := &dpet.ScannerInfo{CrystalNumX: 8, CrystalNumY: 8}
+	config := Config{TimingWindow: 500, EnergyWindowStart: 350, EnergyWindowEnd: 650, Layout: testLayout}
+
+	res, stats, err := NewSorter(scanner, config).Sort(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats != (Stats{Singles: 2, BadPackets: 1, Pairs: 1}) || len(res.CoinPairs) != 1 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+
+	config.FailOnBadPacket = true
+	if _, _, err = NewSorter(scanner, config).Sort(raw); err != dpet.BDMPacketError {
+		t.Fatalf("expected BDMPacketError, got %v", err)
+	}
+
+	// 严格检查包头、包尾标志时，标志不符的数据包同样被跳过
+	config.FailOnBadPacket = false
+	config.Layout.Strict, config.Layout.Head = true, 0x3
+	if _, stats, err = NewSorter(scanner, config).Sort(raw); err != nil || stats.BadPackets != 3 {
+		t.Fatalf("unexpected stats: %+v %v", stats, err)
+	}
+
+	// 未设置位域参数时不进行处理
+	config.Layout = dpet.BDMLayout{}
+	if _, _, err = NewSorter(scanner, config).Sort(raw); !errors.Is(err, dpet.BDMFieldOutOfRange) {
+		t.Fatalf("expected BDMFieldOutOfRange, got %v", err)
+	}
+}
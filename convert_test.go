@@ -10,7 +10,7 @@ import (
 )
 
 func TestConvertor930_Convert(t *testing.T) {
-	dataset, _ := dpetk.ParseFile("test.bin", false)
+	dataset, _ := dpetk.ParseFile("test.bin", dpetk.NotParseData())
 	c := convert.Convertor930{Source: dataset}
 	dicomDataset, _ := c.Convert()
 	f, _ := os.Create("out.dcm")
@@ -0,0 +1,121 @@
+package dpet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// BDMLayout E180原始数据BDMInfoBody的位域参数：
+//
+//	HeadAndDU          高4位为包头标志Head，低4位为DU编号
+//	Time               小端序，低2字节为精细时间（ps，小于CoarsePeriod），高6字节为粗时间计数
+//	Energy             小端序ADC能量值
+//	TemperatureInt     温度整数部分（摄氏度）
+//	TemperatureAndTail 高4位为温度小数部分（1/16摄氏度），低4位为包尾标志Tail
+//
+// 本包没有可引用的E180数据包格式说明，因此不提供默认的位域参数，Head、Tail及CoarsePeriod须由调用方按设备说明给出。
+// 默认只检查字段长度，Strict为true时才检查包头、包尾标志及精细时间的范围
+type BDMLayout struct {
+	Head uint8
+	Tail uint8
+	// 粗时间计数周期（ps），不大于精细时间2字节所能表示的范围
+	CoarsePeriod uint64
+	Strict       bool
+}
+
+// SingleE180 由BDMInfoBody解码得到的单事件
+type SingleE180 struct {
+	DU  uint8
+	BDM uint8
+	// 晶体位置
+	X uint8
+	Y uint8
+	// 时间戳（ps）
+	Time uint64
+	// ADC能量值
+	Energy uint16
+	// 温度（摄氏度），精度为1/16摄氏度
+	Temperature float32
+}
+
+// Validate 检查位域参数，CoarsePeriod未设置或超出精细时间的范围时返回BDMFieldOutOfRange
+func (l BDMLayout) Validate() error {
+	if l.CoarsePeriod == 0 || l.CoarsePeriod > 1<<16 {
+		return fmt.Errorf("%w: coarse period %d", BDMFieldOutOfRange, l.CoarsePeriod)
+	}
+	return nil
+}
+
+// Decode 按位域参数将BDMInfoBody解码为单事件，字段长度不合法，
+// 或Strict时包头、包尾标志及精细时间不合法时返回BDMPacketError
+func (l BDMLayout) Decode(b *BDMInfoBody) (SingleE180, error) {
+	if err := l.Validate(); err != nil {
+		return SingleE180{}, err
+	}
+	if len(b.Time) != 8 || len(b.Energy) != 2 {
+		return SingleE180{}, BDMPacketError
+	}
+	fine := uint64(binary.LittleEndian.Uint16(b.Time))
+	if l.Strict && (b.HeadAndDU>>4 != l.Head || b.TemperatureAndTail&0xf != l.Tail || fine >= l.CoarsePeriod) {
+		return SingleE180{}, BDMPacketError
+	}
+	coarse := binary.LittleEndian.Uint64(b.Time) >> 16
+	return SingleE180{
+		DU:          b.HeadAndDU & 0xf,
+		BDM:         b.BDM,
+		X:           b.X,
+		Y:           b.Y,
+		Time:        coarse*l.CoarsePeriod + fine,
+		Energy:      binary.LittleEndian.Uint16(b.Energy),
+		Temperature: float32(b.TemperatureInt) + float32(b.TemperatureAndTail>>4)/16,
+	}, nil
+}
+
+// Encode 将单事件编码为BDMInfoBody，为Decode的逆操作，温度按1/16摄氏度向下取整
+func (l BDMLayout) Encode(s SingleE180) (*BDMInfoBody, error) {
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+	if s.DU > 0xf {
+		return nil, fmt.Errorf("%w: du %d", BDMFieldOutOfRange, s.DU)
+	}
+	coarse := s.Time / l.CoarsePeriod
+	if coarse >= 1<<48 {
+		return nil, fmt.Errorf("%w: time %d", BDMFieldOutOfRange, s.Time)
+	}
+	sixteenths := math.Floor(float64(s.Temperature) * 16)
+	if sixteenths < math.MinInt8*16 || sixteenths >= (math.MaxInt8+1)*16 {
+		return nil, fmt.Errorf("%w: temperature %v", BDMFieldOutOfRange, s.Temperature)
+	}
+	t := int(sixteenths)
+
+	time := make([]uint8, 8)
+	binary.LittleEndian.PutUint64(time, coarse<<16|s.Time%l.CoarsePeriod)
+	energy := make([]uint8, 2)
+	binary.LittleEndian.PutUint16(energy, s.Energy)
+	return &BDMInfoBody{
+		HeadAndDU: l.Head<<4 | s.DU,
+		BDM:       s.BDM,
+		Time:      time,
+		X:         s.X,
+		Y:         s.Y,
+		Energy:    energy,
+		// 算术右移保证负温度的整数部分向下取整，小数部分非负
+		TemperatureInt:     int8(t >> 4),
+		TemperatureAndTail: uint8(t&0xf)<<4 | l.Tail&0xf,
+	}, nil
+}
+
+// Singles 解码BDM信息块中的全部单事件
+func (l BDMLayout) Singles(info *BDMInfo) ([]SingleE180, error) {
+	singles := make([]SingleE180, len(info.Content))
+	for i, body := range info.Content {
+		single, err := l.Decode(body)
+		if err != nil {
+			return nil, err
+		}
+		singles[i] = single
+	}
+	return singles, nil
+}
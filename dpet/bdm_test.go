@@ -0,0 +1,63 @@
+package dpet
+
+import (
+	"errors"
+	"testing"
+)
+
+// testBDMLayout 测试用的位域参数，仅用于编解码往返，不代表设备的实际取值
+var testBDMLayout = BDMLayout{Head: 0xa, Tail: 0x5, CoarsePeriod: 5000}
+
+func TestBDMInfoBody(t *testing.T) {
+	singles := []SingleE180{
+		{DU: 3, BDM: 7, X: 12, Y: 5, Time: 123456789012, Energy: 1023, Temperature: 36.5},
+		{DU: 15, BDM: 0, Time: 4999, Energy: 65535, Temperature: -3.25},
+	}
+	info := &BDMInfo{}
+	for _, single := range singles {
+		body, err := testBDMLayout.Encode(single)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info.Content = append(info.Content, body)
+	}
+	if body := info.Content[0]; body.HeadAndDU != 0xa3 || body.TemperatureInt != 36 || body.TemperatureAndTail != 0x85 {
+		t.Fatalf("unexpected body: %+v", body)
+	}
+	decoded, err := testBDMLayout.Singles(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range singles {
+		if decoded[i] != singles[i] {
+			t.Fatalf("single %d mismatch: %+v %+v", i, decoded[i], singles[i])
+		}
+	}
+
+	// 默认不检查包头、包尾标志
+	info.Content[1].TemperatureAndTail = 0
+	info.Content = append(info.Content, &BDMInfoBody{HeadAndDU: 1, BDM: 2, Time: []uint8{3, 4, 0, 0, 0, 0, 0, 0}, Energy: []uint8{7, 8}})
+	if _, err = testBDMLayout.Singles(info); err != nil {
+		t.Fatal(err)
+	}
+	strict := testBDMLayout
+	strict.Strict = true
+	for _, body := range info.Content[1:] {
+		if _, err = strict.Decode(body); err != BDMPacketError {
+			t.Fatalf("expected BDMPacketError, got %v", err)
+		}
+	}
+	if _, err = testBDMLayout.Decode(&BDMInfoBody{Time: []uint8{3, 4}, Energy: []uint8{7, 8}}); err != BDMPacketError {
+		t.Fatalf("expected BDMPacketError, got %v", err)
+	}
+	if _, err = testBDMLayout.Encode(SingleE180{DU: 16}); !errors.Is(err, BDMFieldOutOfRange) {
+		t.Fatalf("expected BDMFieldOutOfRange, got %v", err)
+	}
+	if _, err = testBDMLayout.Encode(SingleE180{Temperature: 128}); !errors.Is(err, BDMFieldOutOfRange) {
+		t.Fatalf("expected BDMFieldOutOfRange, got %v", err)
+	}
+	// 未设置位域参数
+	if _, err = (BDMLayout{}).Decode(info.Content[0]); !errors.Is(err, BDMFieldOutOfRange) {
+		t.Fatalf("expected BDMFieldOutOfRange, got %v", err)
+	}
+}
@@ -0,0 +1,360 @@
+package dpet
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// DefaultBlockRecords DeflateBlocks传输语义下每块默认包含的记录数
+const DefaultBlockRecords = 1 << 16
+
+// blockSpan 未压缩数据区中的一块
+type blockSpan struct {
+	start, end int
+	block      *DataBlock
+}
+
+// splitBlocks 按记录将未压缩的数据区划分为每块至多records条记录的块
+func splitBlocks(content *PetFileHeader, data []byte, records int) ([]blockSpan, error) {
+	var spans []blockSpan
+	for pos := 0; pos < len(data); {
+		span := blockSpan{start: pos, block: &DataBlock{}}
+		for pos < len(data) && span.block.RecordCount < uint64(records) {
+			l, ok := recordSize(content, data[pos:])
+			if !ok {
+				// 无法按记录划分的数据区整体视为一条记录
+				l = len(data) - pos
+			}
+			if l > len(data)-pos {
+				return nil, io.ErrUnexpectedEOF
+			}
+			if t, ok := recordTime(content, data[pos:pos+l]); ok {
+				if span.block.RecordCount == 0 {
+					span.block.FirstTime = t
+				}
+				span.block.LastTime = t
+			}
+			span.block.RecordCount++
+			pos += l
+		}
+		span.end = pos
+		spans = append(spans, span)
+	}
+	return spans, nil
+}
+
+// recordHeadLen 确定记录长度所需读取的最大字节数，即E180原始数据BDM信息块头的长度
+const recordHeadLen = 1 + 2 + 2 + 1 + 1 + 4
+
+// recordSize 根据head起始处的至多recordHeadLen个字节返回记录长度，
+// 长度可能大于len(head)，文件类型不能按记录划分时返回false
+func recordSize(content *PetFileHeader, head []byte) (int, bool) {
+	fileType := content.GetPublicInfo().GetFileType()
+	switch device := content.GetScannerInfo().GetDevice(); {
+	case is930(device):
+		switch fileType {
+		case FileType_RawData:
+			return RawDataPacketLen930 + 2, true
+		case FileType_ListModeCoin:
+			return 2 + 2 + 4 + 8, true
+		case FileType_Mich:
+			return 2, true
+		}
+	case isE180(device):
+		switch fileType {
+		case FileType_RawData:
+			if len(head) < recordHeadLen {
+				return recordHeadLen, true
+			}
+			return recordHeadLen + int(binary.LittleEndian.Uint32(head[7:]))/BDMInfoBodyByteLen*BDMInfoBodyByteLen, true
+		case FileType_ListModeCoin:
+			return 2 * (4 + 4 + 8), true
+		case FileType_Mich:
+			return 4, true
+		}
+	}
+	return 0, false
+}
+
+// recordTime 返回记录的事件时间，E180符合事件对取第一个事件的时间
+func recordTime(content *PetFileHeader, record []byte) (float64, bool) {
+	if content.GetPublicInfo().GetFileType() != FileType_ListModeCoin {
+		return 0, false
+	}
+	device := content.GetScannerInfo().GetDevice()
+	if !is930(device) && !isE180(device) {
+		return 0, false
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(record[8:])), true
+}
+
+// compressBlocks 并行压缩各块，将块在数据区中的偏移及压缩后的长度写入块索引，返回各块压缩后的数据
+func compressBlocks(data []byte, spans []blockSpan, level int) ([][]byte, error) {
+	compressed := make([][]byte, len(spans))
+	err := parallel(len(spans), func(i int) error {
+		buf := bytes.NewBuffer(nil)
+		fw, err := flate.NewWriter(buf, level)
+		if err != nil {
+			return err
+		}
+		if _, err = fw.Write(data[spans[i].start:spans[i].end]); err != nil {
+			return err
+		}
+		if err = fw.Close(); err != nil {
+			return err
+		}
+		compressed[i] = buf.Bytes()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var offset uint64
+	for i, span := range spans {
+		span.block.Offset = offset
+		span.block.CompressedSize = uint64(len(compressed[i]))
+		offset += span.block.CompressedSize
+	}
+	return compressed, nil
+}
+
+// parallel 使用至多GOMAXPROCS个goroutine对[0,n)执行f，返回第一个错误
+func parallel(n int, f func(i int) error) error {
+	errs := make([]error, n)
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = f(i)
+			<-sem
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blockStream 顺序解压分块数据区，各块须按索引顺序紧密排列
+type blockStream struct {
+	reader   io.Reader
+	blocks   []*DataBlock
+	offset   uint64
+	inflater io.ReadCloser
+}
+
+func (s *blockStream) Read(p []byte) (int, error) {
+	for {
+		if s.inflater == nil {
+			if len(s.blocks) == 0 {
+				return 0, io.EOF
+			}
+			block := s.blocks[0]
+			if block.Offset != s.offset {
+				return 0, BlockIndexError
+			}
+			s.blocks, s.offset = s.blocks[1:], s.offset+block.CompressedSize
+			s.inflater = flate.NewReader(io.LimitReader(s.reader, int64(block.CompressedSize)))
+		}
+		n, err := s.inflater.Read(p)
+		if err == io.EOF {
+			s.inflater.Close()
+			s.inflater = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (s *blockStream) Close() error {
+	if s.inflater == nil {
+		return nil
+	}
+	return s.inflater.Close()
+}
+
+// BlockReader 分块数据区的随机读取器，可按块、记录序号或事件时间定位，并并行解压多个块
+type BlockReader struct {
+	header *Header
+	reader io.ReaderAt
+	// 数据区在文件中的起始位置
+	base   int64
+	closer io.Closer
+}
+
+// OpenBlockFile 打开传输语义为DeflateBlocks的文件，使用完毕后需调用Close
+func OpenBlockFile(path string) (*BlockReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	b, err := NewBlockReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	b.closer = f
+	return b, nil
+}
+
+// NewBlockReader 从reader中读取文件头，文件的传输语义须为DeflateBlocks
+func NewBlockReader(reader io.ReaderAt) (*BlockReader, error) {
+	header, err := readHead(bufio.NewReader(io.NewSectionReader(reader, 0, math.MaxInt64)))
+	if err != nil {
+		return nil, err
+	}
+	if header.Content.GetPublicInfo().GetDataTransferSyntax() != DataTransferSyntax_DeflateBlocks {
+		return nil, NotBlockSyntax
+	}
+	return &BlockReader{
+		header: header,
+		reader: reader,
+		base:   int64(len(MagicNumber)) + 2 + 4 + int64(header.DataLen),
+	}, nil
+}
+
+// Header 返回文件头
+func (b *BlockReader) Header() *Header {
+	return b.header
+}
+
+// Blocks 返回块索引
+func (b *BlockReader) Blocks() []*DataBlock {
+	return b.header.Content.DataBlocks
+}
+
+// Close 关闭由OpenBlockFile打开的文件
+func (b *BlockReader) Close() error {
+	if b.closer == nil {
+		return nil
+	}
+	return b.closer.Close()
+}
+
+// ReadBlock 读取并解压第i块
+func (b *BlockReader) ReadBlock(i int) ([]byte, error) {
+	if i < 0 || i >= len(b.Blocks()) {
+		return nil, BlockIndexError
+	}
+	inflater := flate.NewReader(b.section(i))
+	defer inflater.Close()
+	buf := bytes.NewBuffer(nil)
+	if _, err := buf.ReadFrom(inflater); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ReadBlocks 并行解压[start,end)中的块，按块顺序拼接返回
+func (b *BlockReader) ReadBlocks(start, end int) ([]byte, error) {
+	if start < 0 || start > end || end > len(b.Blocks()) {
+		return nil, BlockIndexError
+	}
+	blocks := make([][]byte, end-start)
+	err := parallel(len(blocks), func(i int) (err error) {
+		blocks[i], err = b.ReadBlock(start + i)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Join(blocks, nil), nil
+}
+
+// FindRecord 返回第index条记录（从0开始）所在的块及其在块内的序号
+func (b *BlockReader) FindRecord(index uint64) (block int, offset uint64, err error) {
+	for i, blk := range b.Blocks() {
+		if index < blk.RecordCount {
+			return i, index, nil
+		}
+		index -= blk.RecordCount
+	}
+	return 0, 0, RecordOutOfRange
+}
+
+// FindTime 返回第一个末条记录时间不早于t的块，各块须按时间先后排列，没有这样的块时返回块数
+func (b *BlockReader) FindTime(t float64) int {
+	blocks := b.Blocks()
+	return sort.Search(len(blocks), func(i int) bool {
+		return blocks[i].LastTime >= t
+	})
+}
+
+// SeekRecord 返回从第index条记录开始读取的Reader，可使用其Next系列方法继续顺序读取
+func (b *BlockReader) SeekRecord(index uint64) (*Reader, error) {
+	block, offset, err := b.FindRecord(index)
+	if err != nil {
+		return nil, err
+	}
+	r := b.readerFrom(block)
+	for ; offset > 0; offset-- {
+		if err = r.skipRecord(); err != nil {
+			r.Close()
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// SeekTime 返回从FindTime(t)所在块开始读取的Reader，定位精度为块，块内早于t的记录需由调用方跳过
+func (b *BlockReader) SeekTime(t float64) (*Reader, error) {
+	block := b.FindTime(t)
+	if block == len(b.Blocks()) {
+		return nil, RecordOutOfRange
+	}
+	return b.readerFrom(block), nil
+}
+
+// section 返回第i块压缩数据
+func (b *BlockReader) section(i int) *io.SectionReader {
+	block := b.Blocks()[i]
+	return io.NewSectionReader(b.reader, b.base+int64(block.Offset), int64(block.CompressedSize))
+}
+
+// readerFrom 返回从第block块开始顺序读取的Reader
+func (b *BlockReader) readerFrom(block int) *Reader {
+	blocks := b.Blocks()
+	stream := &blockStream{
+		reader: io.NewSectionReader(b.reader, b.base+int64(blocks[block].Offset), math.MaxInt64),
+		blocks: blocks[block:],
+		offset: blocks[block].Offset,
+	}
+	return &Reader{
+		header:   b.header,
+		data:     bufio.NewReader(stream),
+		inflater: stream,
+	}
+}
+
+// skipRecord 跳过一条记录
+func (r *Reader) skipRecord() error {
+	head, err := r.data.Peek(recordHeadLen)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	l, ok := recordSize(r.header.Content, head)
+	if !ok {
+		return UnknownFileType
+	}
+	if n, _ := r.data.Discard(l); n < l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package dpet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestBlocks(t *testing.T) {
+	// 第i条记录的时间为i
+	data := testListModeData930(100)
+	for i := 0; i < 100; i++ {
+		binary.LittleEndian.PutUint64(data[i*16+8:], math.Float64bits(float64(i)))
+	}
+	dataset := &Dataset{
+		Header:  testHeader(File930, FileType_ListModeCoin),
+		DataBuf: bytes.NewBuffer(data),
+	}
+	buf := bytes.NewBuffer(nil)
+	err := Write(dataset, buf, WithTransferSyntax(DataTransferSyntax_DeflateBlocks), WithBlockRecords(30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded := buf.Bytes()
+
+	// 顺序读取
+	parsed, err := Parse(bytes.NewBuffer(encoded), NotParseData(), VerifyMD5())
+	if err != nil || !bytes.Equal(parsed.DataBuf.Bytes(), data) {
+		t.Fatalf("sequential read mismatch: %v", err)
+	}
+
+	b, err := NewBlockReader(bytes.NewReader(encoded))
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocks := b.Blocks()
+	if len(blocks) != 4 || blocks[3].RecordCount != 10 || blocks[1].FirstTime != 30 || blocks[1].LastTime != 59 {
+		t.Fatalf("unexpected blocks: %v", blocks)
+	}
+	all, err := b.ReadBlocks(0, len(blocks))
+	if err != nil || !bytes.Equal(all, data) {
+		t.Fatalf("parallel read mismatch: %v", err)
+	}
+
+	r, err := b.SeekRecord(45)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 45; i < 100; i++ {
+		item, err := r.NextListModeItem930()
+		if err != nil || item.Time != float64(i) {
+			t.Fatalf("unexpected item %d: %+v %v", i, item, err)
+		}
+	}
+	if _, err = r.NextListModeItem930(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+	r.Close()
+
+	r, err = b.SeekTime(65.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item, err := r.NextListModeItem930(); err != nil || item.Time != 60 {
+		t.Fatalf("unexpected item: %+v %v", item, err)
+	}
+	r.Close()
+	if _, err = b.SeekRecord(100); err != RecordOutOfRange {
+		t.Fatalf("expected RecordOutOfRange, got %v", err)
+	}
+	if _, err = b.SeekTime(100); err != RecordOutOfRange {
+		t.Fatalf("expected RecordOutOfRange, got %v", err)
+	}
+
+	if _, err = NewEncoder(io.Discard, parsed.Header); err != BlockSyntaxNotStreamable {
+		t.Fatalf("expected BlockSyntaxNotStreamable, got %v", err)
+	}
+}
+
+func TestBlocksVariableRecords(t *testing.T) {
+	var infos []*BDMInfo
+	for i := 0; i < 5; i++ {
+		info := &BDMInfo{BDMIndex: uint8(i), DataLen: uint32(i * BDMInfoBodyByteLen)}
+		for j := 0; j < i; j++ {
+			info.Content = append(info.Content, &BDMInfoBody{Time: make([]uint8, 8), Energy: make([]uint8, 2)})
+		}
+		infos = append(infos, info)
+	}
+	dataset := &Dataset{
+		Header: testHeader(FileE180, FileType_RawData),
+		Data:   &RawDataE180{BDMInfos: infos},
+	}
+	buf := bytes.NewBuffer(nil)
+	err := Write(dataset, buf, WithTransferSyntax(DataTransferSyntax_DeflateBlocks), WithBlockRecords(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewBlockReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := b.SeekRecord(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	info, err := r.NextBDMInfoE180()
+	if err != nil || info.BDMIndex != 3 || len(info.Content) != 3 {
+		t.Fatalf("unexpected info: %+v %v", info, err)
+	}
+}
@@ -0,0 +1,60 @@
+package dpet
+
+import (
+	"io"
+	"sync"
+)
+
+// Codec 设备数据区编解码器，按文件类型解析及写出未压缩的数据区
+type Codec interface {
+	// Decode 从r中读取整个数据区并解析为fileType对应的数据，可使用r.Data()读取解压后的数据区
+	Decode(r *Reader, fileType FileType) (interface{}, error)
+	// Encode 将data写为未压缩的数据区，文件类型见content.PublicInfo.FileType
+	Encode(content *PetFileHeader, data interface{}, w io.Writer) error
+}
+
+var (
+	codecsMu sync.RWMutex
+	codecs   = map[string]Codec{}
+)
+
+func init() {
+	Register(File930, codec930{})
+	Register(FileI30, codecI30{})
+	Register(FileE180, codecE180{})
+}
+
+// Register 注册设备的编解码器，deviceName与文件头中的ScannerInfo.Device比较，重复注册时覆盖已有的编解码器
+func Register(deviceName string, codec Codec) {
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+	codecs[deviceName] = codec
+}
+
+// LookupCodec 返回设备对应的编解码器
+func LookupCodec(deviceName string) (Codec, bool) {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+	codec, ok := codecs[deviceName]
+	return codec, ok
+}
+
+type codec930 struct{}
+
+func (codec930) Decode(r *Reader, fileType FileType) (interface{}, error) {
+	return parseData930(r, fileType)
+}
+
+func (codec930) Encode(content *PetFileHeader, data interface{}, w io.Writer) error {
+	return writeData930(content, data, w)
+}
+
+type codecE180 struct{}
+
+func (codecE180) Decode(r *Reader, fileType FileType) (interface{}, error) {
+	return parseDataE180(r, fileType)
+}
+
+func (codecE180) Encode(content *PetFileHeader, data interface{}, w io.Writer) error {
+	return writeDataE180(content, data, w)
+}
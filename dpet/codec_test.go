@@ -0,0 +1,55 @@
+package dpet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// testCodec 以int32存放mich的自定义设备
+type testCodec struct{}
+
+func (testCodec) Decode(r *Reader, fileType FileType) (interface{}, error) {
+	if fileType != FileType_Mich {
+		return nil, UnknownFileType
+	}
+	data, err := io.ReadAll(r.Data())
+	if err != nil {
+		return nil, err
+	}
+	mich := make([]int32, len(data)/4)
+	return mich, binary.Read(bytes.NewReader(data), binary.LittleEndian, mich)
+}
+
+func (testCodec) Encode(content *PetFileHeader, data interface{}, w io.Writer) error {
+	mich, ok := data.([]int32)
+	if !ok || content.PublicInfo.FileType != FileType_Mich {
+		return DataTypeMismatch
+	}
+	return binary.Write(w, binary.LittleEndian, mich)
+}
+
+func TestRegister(t *testing.T) {
+	dataset := &Dataset{Header: testHeader("test-prototype", FileType_Mich), Data: []int32{-1, 2, 3}}
+	if err := Write(dataset, bytes.NewBuffer(nil)); err != UnknownDrive {
+		t.Fatalf("expected UnknownDrive, got %v", err)
+	}
+
+	Register("test-prototype", testCodec{})
+	buf := bytes.NewBuffer(nil)
+	if err := Write(dataset, buf, WriteVerified()); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := Parse(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(parsed.Data, dataset.Data) {
+		t.Fatalf("unexpected data: %v", parsed.Data)
+	}
+	if _, ok := LookupCodec(FileI30); !ok {
+		t.Fatal("i30 codec should be registered")
+	}
+}
@@ -8,7 +8,7 @@ import (
 
 func ParseFrom930(buf *bytes.Buffer) (*Dataset, error) {
 	reader := bytes.NewReader(buf.Bytes())
-	dataset930, err := dpetk.Parse(reader, false)
+	dataset930, err := dpetk.Parse(reader, dpetk.NotParseData())
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +37,7 @@ func TestConvertFrom930(t *testing.T) {
 }
 
 func TestConvertFrom930File(t *testing.T) {
-	dataset, err := dpetk.ParseFile("../resource/test-raw-data.bin", false)
+	dataset, err := dpetk.ParseFile("../resource/test-raw-data.bin", dpetk.NotParseData())
 	if err != nil {
 		fmt.Println(err.Error())
 		t.FailNow()
@@ -0,0 +1,159 @@
+package dpet
+
+// Mich 不同设备的mich数据，930为uint16计数，E180为float32
+type Mich interface {
+	Len() int
+	// At 返回第i个mich值
+	At(i int) float64
+}
+
+// Mich930 930 mich数据
+type Mich930 []uint16
+
+func (m Mich930) Len() int {
+	return len(m)
+}
+
+func (m Mich930) At(i int) float64 {
+	return float64(m[i])
+}
+
+// MichE180 E180 mich数据
+type MichE180 []float32
+
+func (m MichE180) Len() int {
+	return len(m)
+}
+
+func (m MichE180) At(i int) float64 {
+	return float64(m[i])
+}
+
+// RawData930 返回930原始数据，设备或文件类型不符时返回DataTypeMismatch
+func (d *Dataset) RawData930() (*RawData930, error) {
+	data, err := d.data(is930, FileType_RawData)
+	if err != nil {
+		return nil, err
+	}
+	res, ok := data.(*RawData930)
+	if !ok {
+		return nil, DataTypeMismatch
+	}
+	return res, nil
+}
+
+// ListMode930 返回930符合信息
+func (d *Dataset) ListMode930() (*ListModeCoinData930, error) {
+	data, err := d.data(is930, FileType_ListModeCoin)
+	if err != nil {
+		return nil, err
+	}
+	res, ok := data.(*ListModeCoinData930)
+	if !ok {
+		return nil, DataTypeMismatch
+	}
+	return res, nil
+}
+
+// RawDataE180 返回E180原始数据
+func (d *Dataset) RawDataE180() (*RawDataE180, error) {
+	data, err := d.data(isE180, FileType_RawData)
+	if err != nil {
+		return nil, err
+	}
+	res, ok := data.(*RawDataE180)
+	if !ok {
+		return nil, DataTypeMismatch
+	}
+	return res, nil
+}
+
+// CoinPairsE180 返回E180符合事件对
+func (d *Dataset) CoinPairsE180() ([]CoinPair, error) {
+	data, err := d.data(isE180, FileType_ListModeCoin)
+	if err != nil {
+		return nil, err
+	}
+	res, ok := data.(*ListModeCoinDataE180)
+	if !ok {
+		return nil, DataTypeMismatch
+	}
+	return res.CoinPairs, nil
+}
+
+// Mich 返回mich数据，930及E180均可使用
+func (d *Dataset) Mich() (Mich, error) {
+	data, err := d.data(anyDevice, FileType_Mich)
+	if err != nil {
+		return nil, err
+	}
+	switch res := data.(type) {
+	case []uint16:
+		return Mich930(res), nil
+	case []float32:
+		return MichE180(res), nil
+	}
+	return nil, DataTypeMismatch
+}
+
+// Image 返回重建图像
+func (d *Dataset) Image() (*Image, error) {
+	data, err := d.data(anyDevice, FileType_Img)
+	if err != nil {
+		return nil, err
+	}
+	res, ok := data.(*Image)
+	if !ok {
+		return nil, DataTypeMismatch
+	}
+	return res, nil
+}
+
+// CalibrationMap 返回能量刻度表或时间刻度表
+func (d *Dataset) CalibrationMap() (*CalibrationMap, error) {
+	data, err := d.data(anyDevice, FileType_EnergyCalibrationMap, FileType_TimeCalibrationMap)
+	if err != nil {
+		return nil, err
+	}
+	res, ok := data.(*CalibrationMap)
+	if !ok {
+		return nil, DataTypeMismatch
+	}
+	return res, nil
+}
+
+// EnergySpectrum 返回能谱
+func (d *Dataset) EnergySpectrum() (*EnergySpectrum, error) {
+	data, err := d.data(anyDevice, FileType_EnergySpectrumData)
+	if err != nil {
+		return nil, err
+	}
+	res, ok := data.(*EnergySpectrum)
+	if !ok {
+		return nil, DataTypeMismatch
+	}
+	return res, nil
+}
+
+func anyDevice(string) bool {
+	return true
+}
+
+// data 检查设备及文件类型后返回Data，未解析数据区时返回DataNotParsed
+func (d *Dataset) data(device func(string) bool, fileTypes ...FileType) (interface{}, error) {
+	content := d.Header.Content
+	if !device(content.GetScannerInfo().GetDevice()) {
+		return nil, DataTypeMismatch
+	}
+	matched := false
+	for _, fileType := range fileTypes {
+		matched = matched || content.GetPublicInfo().GetFileType() == fileType
+	}
+	if !matched {
+		return nil, DataTypeMismatch
+	}
+	if d.Data == nil {
+		return nil, DataNotParsed
+	}
+	return d.Data, nil
+}
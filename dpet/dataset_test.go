@@ -0,0 +1,47 @@
+package dpet
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDatasetAccessors(t *testing.T) {
+	dataset := &Dataset{Header: testHeader(File930, FileType_Mich), Data: []uint16{1, 2, 3}}
+	mich, err := dataset.Mich()
+	if err != nil || mich.Len() != 3 || mich.At(2) != 3 {
+		t.Fatalf("unexpected mich: %v %v", mich, err)
+	}
+	if _, err = dataset.ListMode930(); err != DataTypeMismatch {
+		t.Fatalf("expected DataTypeMismatch, got %v", err)
+	}
+
+	dataset = &Dataset{Header: testHeader(FileE180, FileType_Mich), Data: []float32{0.5}}
+	if mich, err = dataset.Mich(); err != nil || mich.At(0) != 0.5 {
+		t.Fatalf("unexpected mich: %v %v", mich, err)
+	}
+
+	pairs := []CoinPair{{{GlobalCrystalIndex: 1}, {GlobalCrystalIndex: 2}}}
+	dataset = &Dataset{Header: testHeader(FileE180, FileType_ListModeCoin), Data: &ListModeCoinDataE180{CoinPairs: pairs}}
+	buf := bytes.NewBuffer(nil)
+	if err = Write(dataset, buf); err != nil {
+		t.Fatal(err)
+	}
+	encoded := buf.Bytes()
+	parsed, err := Parse(bytes.NewBuffer(encoded))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, err := parsed.CoinPairsE180(); err != nil || len(res) != 1 || res[0][1].GlobalCrystalIndex != 2 {
+		t.Fatalf("unexpected coin pairs: %v %v", res, err)
+	}
+	if _, err = parsed.ListMode930(); err != DataTypeMismatch {
+		t.Fatalf("expected DataTypeMismatch, got %v", err)
+	}
+	parsed, err = Parse(bytes.NewBuffer(encoded), NotParseData())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = parsed.CoinPairsE180(); err != DataNotParsed {
+		t.Fatalf("expected DataNotParsed, got %v", err)
+	}
+}
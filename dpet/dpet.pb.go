@@ -92,16 +92,28 @@ func (FileType) EnumDescriptor() ([]byte, []int) {
 type DataTransferSyntax int32
 
 const (
-	DataTransferSyntax_Deflate DataTransferSyntax = 0
+	DataTransferSyntax_Deflate       DataTransferSyntax = 0
+	DataTransferSyntax_Uncompressed  DataTransferSyntax = 1 // 不压缩，便于内存映射及快速读取
+	DataTransferSyntax_Zlib          DataTransferSyntax = 2 // zlib格式，带adler32校验
+	DataTransferSyntax_Gzip          DataTransferSyntax = 3 // gzip格式，带crc32校验
+	DataTransferSyntax_DeflateBlocks DataTransferSyntax = 4 // 按记录分块，每块独立deflate压缩，块索引见PetFileHeader.dataBlocks
 )
 
 // Enum value maps for DataTransferSyntax.
 var (
 	DataTransferSyntax_name = map[int32]string{
 		0: "Deflate",
+		1: "Uncompressed",
+		2: "Zlib",
+		3: "Gzip",
+		4: "DeflateBlocks",
 	}
 	DataTransferSyntax_value = map[string]int32{
-		"Deflate": 0,
+		"Deflate":       0,
+		"Uncompressed":  1,
+		"Zlib":          2,
+		"Gzip":          3,
+		"DeflateBlocks": 4,
 	}
 )
 
@@ -143,6 +155,7 @@ type PetFileHeader struct {
 	ScannerInfo     *ScannerInfo     `protobuf:"bytes,4,opt,name=scannerInfo,proto3" json:"scannerInfo,omitempty"`
 	CoincidenceInfo *CoincidenceInfo `protobuf:"bytes,5,opt,name=coincidenceInfo,proto3" json:"coincidenceInfo,omitempty"`
 	ImageInfo       *ImageInfo       `protobuf:"bytes,6,opt,name=imageInfo,proto3" json:"imageInfo,omitempty"`
+	DataBlocks      []*DataBlock     `protobuf:"bytes,7,rep,name=dataBlocks,proto3" json:"dataBlocks,omitempty"` // 传输语义为DeflateBlocks时的块索引
 }
 
 func (x *PetFileHeader) Reset() {
@@ -219,6 +232,13 @@ func (x *PetFileHeader) GetImageInfo() *ImageInfo {
 	return nil
 }
 
+func (x *PetFileHeader) GetDataBlocks() []*DataBlock {
+	if x != nil {
+		return x.DataBlocks
+	}
+	return nil
+}
+
 // 公共信息
 type PublicInfo struct {
 	state         protoimpl.MessageState
@@ -283,6 +303,86 @@ func (x *PublicInfo) GetMD5() string {
 	return ""
 }
 
+// 分块数据区中的一块
+type DataBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset         uint64  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`                 // 块在数据区中的偏移，数据区起始为0
+	CompressedSize uint64  `protobuf:"varint,2,opt,name=compressedSize,proto3" json:"compressedSize,omitempty"` // 块压缩后的字节数
+	RecordCount    uint64  `protobuf:"varint,3,opt,name=recordCount,proto3" json:"recordCount,omitempty"`       // 块内记录条数
+	FirstTime      float64 `protobuf:"fixed64,4,opt,name=firstTime,proto3" json:"firstTime,omitempty"`          // 块内首条记录的事件时间，无时间信息的记录为0
+	LastTime       float64 `protobuf:"fixed64,5,opt,name=lastTime,proto3" json:"lastTime,omitempty"`            // 块内末条记录的事件时间
+}
+
+func (x *DataBlock) Reset() {
+	*x = DataBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dpet_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataBlock) ProtoMessage() {}
+
+func (x *DataBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_dpet_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataBlock.ProtoReflect.Descriptor instead.
+func (*DataBlock) Descriptor() ([]byte, []int) {
+	return file_dpet_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DataBlock) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DataBlock) GetCompressedSize() uint64 {
+	if x != nil {
+		return x.CompressedSize
+	}
+	return 0
+}
+
+func (x *DataBlock) GetRecordCount() uint64 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *DataBlock) GetFirstTime() float64 {
+	if x != nil {
+		return x.FirstTime
+	}
+	return 0
+}
+
+func (x *DataBlock) GetLastTime() float64 {
+	if x != nil {
+		return x.LastTime
+	}
+	return 0
+}
+
 // 扫描信息
 type ScanInfo struct {
 	state         protoimpl.MessageState
@@ -321,7 +421,7 @@ type ScanInfo struct {
 func (x *ScanInfo) Reset() {
 	*x = ScanInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dpet_proto_msgTypes[2]
+		mi := &file_dpet_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -334,7 +434,7 @@ func (x *ScanInfo) String() string {
 func (*ScanInfo) ProtoMessage() {}
 
 func (x *ScanInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_dpet_proto_msgTypes[2]
+	mi := &file_dpet_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +447,7 @@ func (x *ScanInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanInfo.ProtoReflect.Descriptor instead.
 func (*ScanInfo) Descriptor() ([]byte, []int) {
-	return file_dpet_proto_rawDescGZIP(), []int{2}
+	return file_dpet_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ScanInfo) GetAngleNum() int32 {
@@ -578,7 +678,7 @@ type AcquisitionInfo struct {
 func (x *AcquisitionInfo) Reset() {
 	*x = AcquisitionInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dpet_proto_msgTypes[3]
+		mi := &file_dpet_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -591,7 +691,7 @@ func (x *AcquisitionInfo) String() string {
 func (*AcquisitionInfo) ProtoMessage() {}
 
 func (x *AcquisitionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_dpet_proto_msgTypes[3]
+	mi := &file_dpet_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,7 +704,7 @@ func (x *AcquisitionInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcquisitionInfo.ProtoReflect.Descriptor instead.
 func (*AcquisitionInfo) Descriptor() ([]byte, []int) {
-	return file_dpet_proto_rawDescGZIP(), []int{3}
+	return file_dpet_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AcquisitionInfo) GetBuffSize() int32 {
@@ -859,7 +959,7 @@ type ScannerInfo struct {
 func (x *ScannerInfo) Reset() {
 	*x = ScannerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dpet_proto_msgTypes[4]
+		mi := &file_dpet_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -872,7 +972,7 @@ func (x *ScannerInfo) String() string {
 func (*ScannerInfo) ProtoMessage() {}
 
 func (x *ScannerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_dpet_proto_msgTypes[4]
+	mi := &file_dpet_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -885,7 +985,7 @@ func (x *ScannerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScannerInfo.ProtoReflect.Descriptor instead.
 func (*ScannerInfo) Descriptor() ([]byte, []int) {
-	return file_dpet_proto_rawDescGZIP(), []int{4}
+	return file_dpet_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ScannerInfo) GetBlockNumX() int32 {
@@ -1253,7 +1353,7 @@ type CoincidenceInfo struct {
 func (x *CoincidenceInfo) Reset() {
 	*x = CoincidenceInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dpet_proto_msgTypes[5]
+		mi := &file_dpet_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1266,7 +1366,7 @@ func (x *CoincidenceInfo) String() string {
 func (*CoincidenceInfo) ProtoMessage() {}
 
 func (x *CoincidenceInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_dpet_proto_msgTypes[5]
+	mi := &file_dpet_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1279,7 +1379,7 @@ func (x *CoincidenceInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CoincidenceInfo.ProtoReflect.Descriptor instead.
 func (*CoincidenceInfo) Descriptor() ([]byte, []int) {
-	return file_dpet_proto_rawDescGZIP(), []int{5}
+	return file_dpet_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CoincidenceInfo) GetBedNum() int32 {
@@ -1389,7 +1489,7 @@ type ImageInfo struct {
 func (x *ImageInfo) Reset() {
 	*x = ImageInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dpet_proto_msgTypes[6]
+		mi := &file_dpet_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1402,7 +1502,7 @@ func (x *ImageInfo) String() string {
 func (*ImageInfo) ProtoMessage() {}
 
 func (x *ImageInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_dpet_proto_msgTypes[6]
+	mi := &file_dpet_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1415,7 +1515,7 @@ func (x *ImageInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageInfo.ProtoReflect.Descriptor instead.
 func (*ImageInfo) Descriptor() ([]byte, []int) {
-	return file_dpet_proto_rawDescGZIP(), []int{6}
+	return file_dpet_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ImageInfo) GetImageSizeRows() int32 {
@@ -1554,7 +1654,7 @@ func (x *ImageInfo) GetDelayCounts() int32 {
 var File_dpet_proto protoreflect.FileDescriptor
 
 var file_dpet_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x64, 0x70, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb5, 0x02, 0x0a,
+	0x0a, 0x0a, 0x64, 0x70, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe1, 0x02, 0x0a,
 	0x0d, 0x50, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2b,
 	0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52,
@@ -1574,316 +1674,333 @@ var file_dpet_proto_rawDesc = []byte{
 	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x09, 0x69, 0x6d,
 	0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
 	0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x8a, 0x01, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x12, 0x64, 0x61,
-	0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x74, 0x61, 0x78,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x52, 0x12, 0x64, 0x61, 0x74,
-	0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x12,
-	0x10, 0x0a, 0x03, 0x4d, 0x44, 0x35, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x44,
-	0x35, 0x22, 0xba, 0x06, 0x0a, 0x08, 0x53, 0x63, 0x61, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a,
-	0x0a, 0x08, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x74,
-	0x42, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x63, 0x74, 0x42, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x74,
-	0x42, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x74,
-	0x42, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x74, 0x53, 0x63, 0x61, 0x6e,
-	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x74,
-	0x53, 0x63, 0x61, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x74,
-	0x53, 0x63, 0x61, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x63, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x04, 0x64, 0x6f, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x6a, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x6a, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x73, 0x43, 0x6f, 0x69, 0x6e,
-	0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x61, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
-	0x69, 0x73, 0x43, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x1a,
-	0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6d, 0x6f, 0x64, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72,
-	0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x65, 0x74, 0x42, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x70, 0x65, 0x74, 0x42, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c,
-	0x0a, 0x09, 0x70, 0x65, 0x74, 0x42, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x70, 0x65, 0x74, 0x42, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a,
-	0x70, 0x65, 0x74, 0x43, 0x74, 0x46, 0x69, 0x72, 0x73, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x70, 0x65, 0x74, 0x43, 0x74, 0x46, 0x69, 0x72, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x70, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x70, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x41, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x70, 0x61,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x69,
-	0x64, 0x75, 0x61, 0x6c, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x73, 0x69,
-	0x64, 0x75, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x69, 0x64, 0x75, 0x61, 0x6c,
-	0x41, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x69, 0x64, 0x75,
-	0x61, 0x6c, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x19,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72,
-	0x61, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x18,
-	0x1b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x22, 0x83,
-	0x07, 0x0a, 0x0f, 0x41, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x66, 0x66, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x66, 0x66, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x69, 0x73, 0x6f, 0x74, 0x6f, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x69, 0x73, 0x6f, 0x74, 0x6f, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x57, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x61, 0x79,
-	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x78, 0x74, 0x61, 0x6c, 0x6b, 0x57,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x78, 0x74, 0x61,
-	0x6c, 0x6b, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x65, 0x72,
-	0x67, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0c,
-	0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x26, 0x0a, 0x0e,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x45,
-	0x54, 0x43, 0x54, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x13, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x0c, 0x50, 0x45, 0x54, 0x43, 0x54, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x1e,
-	0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x15, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e,
-	0x0a, 0x12, 0x73, 0x63, 0x61, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x50, 0x65, 0x72, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x73, 0x63, 0x61, 0x6e,
-	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x50, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x17, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x74, 0x75, 0x64, 0x79, 0x49, 0x44, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x74,
-	0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x69,
-	0x65, 0x6e, 0x74, 0x53, 0x65, 0x78, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61,
-	0x74, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x78, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x74, 0x69,
-	0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0d, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24,
-	0x0a, 0x0d, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x1c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x22, 0x95, 0x0d, 0x0a, 0x0b, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
-	0x58, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
-	0x6d, 0x58, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x59, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x59,
-	0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x5a, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x5a, 0x12, 0x20,
-	0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x69, 0x74, 0x63, 0x68, 0x58, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x69, 0x74, 0x63, 0x68, 0x58,
-	0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x69, 0x74, 0x63, 0x68, 0x59, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x69, 0x74, 0x63,
-	0x68, 0x59, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x69, 0x74, 0x63, 0x68,
-	0x5a, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x69,
-	0x74, 0x63, 0x68, 0x5a, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a,
-	0x65, 0x58, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
-	0x69, 0x7a, 0x65, 0x58, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a,
-	0x65, 0x59, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
-	0x69, 0x7a, 0x65, 0x59, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a,
-	0x65, 0x5a, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
-	0x69, 0x7a, 0x65, 0x5a, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x4e,
-	0x75, 0x6d, 0x58, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x72, 0x79, 0x73, 0x74,
-	0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x58, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61,
-	0x6c, 0x4e, 0x75, 0x6d, 0x59, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x72, 0x79,
-	0x73, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x59, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72, 0x79, 0x73,
-	0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x5a, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63,
-	0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x5a, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72,
-	0x79, 0x73, 0x74, 0x61, 0x6c, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x0d, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x50, 0x69, 0x74, 0x63, 0x68,
-	0x58, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c,
-	0x50, 0x69, 0x74, 0x63, 0x68, 0x58, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61,
-	0x6c, 0x50, 0x69, 0x74, 0x63, 0x68, 0x59, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x63,
-	0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x50, 0x69, 0x74, 0x63, 0x68, 0x59, 0x12, 0x24, 0x0a, 0x0d,
-	0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x50, 0x69, 0x74, 0x63, 0x68, 0x5a, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x0d, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x50, 0x69, 0x74, 0x63,
-	0x68, 0x5a, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a,
-	0x65, 0x58, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61,
-	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x58, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61,
-	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x59, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x63, 0x72,
-	0x79, 0x73, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x59, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72,
-	0x79, 0x73, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x5a, 0x18, 0x13, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x0c, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x5a, 0x12, 0x1e,
-	0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x58, 0x18, 0x14, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x58, 0x12, 0x1e,
-	0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x59, 0x18, 0x15, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x59, 0x12, 0x1e,
-	0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x5a, 0x18, 0x16, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x5a, 0x12, 0x22,
-	0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x69, 0x74, 0x63, 0x68, 0x58, 0x18, 0x17,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x69, 0x74, 0x63,
-	0x68, 0x58, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x69, 0x74, 0x63,
-	0x68, 0x59, 0x18, 0x18, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x50, 0x69, 0x74, 0x63, 0x68, 0x59, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x50, 0x69, 0x74, 0x63, 0x68, 0x5a, 0x18, 0x19, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x50, 0x69, 0x74, 0x63, 0x68, 0x5a, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x58, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x58, 0x12, 0x20, 0x0a, 0x0b,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x59, 0x18, 0x1b, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x59, 0x12, 0x20,
-	0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x5a, 0x18, 0x1c, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x5a,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x4e, 0x75, 0x6d, 0x18, 0x1d, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x50, 0x69, 0x74, 0x63, 0x68, 0x58, 0x18, 0x1e, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x0b, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x50, 0x69, 0x74, 0x63, 0x68, 0x58, 0x12, 0x20,
-	0x0a, 0x0b, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x50, 0x69, 0x74, 0x63, 0x68, 0x59, 0x18, 0x1f, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x0b, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x50, 0x69, 0x74, 0x63, 0x68, 0x59,
-	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x50, 0x69, 0x74, 0x63, 0x68, 0x5a, 0x18,
-	0x20, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x50, 0x69, 0x74, 0x63,
-	0x68, 0x5a, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x58,
-	0x18, 0x21, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x69, 0x7a,
-	0x65, 0x58, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x59,
-	0x18, 0x22, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x69, 0x7a,
-	0x65, 0x59, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x5a,
-	0x18, 0x23, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x69, 0x7a,
-	0x65, 0x5a, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69,
-	0x7a, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65,
-	0x72, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x25, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x73,
-	0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x27,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d,
-	0x6d, 0x76, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x29, 0x20,
-	0x03, 0x28, 0x02, 0x52, 0x0d, 0x6d, 0x76, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
-	0x64, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x76, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x18, 0x2a, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0d, 0x6d, 0x76, 0x74, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x78, 0x69, 0x73,
-	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0d, 0x61, 0x78, 0x69, 0x73, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x26,
-	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
-	0x18, 0x2c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x44, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x52, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
-	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c,
-	0x0a, 0x11, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x73, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x64, 0x65, 0x74, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x69, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x69, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x70, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x18, 0x30, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x70, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x18, 0x31, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x22, 0xff, 0x02, 0x0a,
-	0x0f, 0x43, 0x6f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x62, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x69, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65,
-	0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x69,
-	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x22, 0x8a, 0x01, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x25, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x09, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x12, 0x64, 0x61, 0x74, 0x61, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x53, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x52, 0x12, 0x64, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x4d,
+	0x44, 0x35, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x44, 0x35, 0x22, 0xa7, 0x01,
+	0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xba, 0x06, 0x0a, 0x08, 0x53, 0x63, 0x61, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x4e, 0x75, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x4e, 0x75, 0x6d,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x74, 0x42, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x74, 0x42, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x74, 0x42, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x63, 0x74, 0x42, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x63, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x65, 0x72,
-	0x67, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x0f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45,
-	0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12,
-	0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x65, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67,
-	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x65,
-	0x72, 0x67, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x22,
-	0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x47, 0x70, 0x75, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x47, 0x70, 0x75, 0x22, 0xf9,
-	0x05, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0d,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x6f,
-	0x77, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x43,
-	0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x43, 0x6f, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6c, 0x69, 0x63,
-	0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x77, 0x50, 0x69,
-	0x78, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x77, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x32, 0x0a, 0x14, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x50,
-	0x69, 0x78, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x50, 0x69, 0x78, 0x65, 0x6c,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x6c, 0x69,
-	0x63, 0x65, 0x54, 0x68, 0x69, 0x63, 0x6b, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x13, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x54, 0x68, 0x69,
-	0x63, 0x6b, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63,
-	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x52,
-	0x69, 0x6e, 0x67, 0x44, 0x69, 0x66, 0x66, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x66, 0x66, 0x4e, 0x75, 0x6d,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x18,
-	0x0a, 0x07, 0x69, 0x74, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x69, 0x74, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x74, 0x74, 0x6e,
-	0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0f, 0x61, 0x74, 0x74, 0x6e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x63, 0x61, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x63, 0x61,
-	0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x63, 0x61, 0x74, 0x50, 0x61, 0x72, 0x61, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x02, 0x52, 0x08,
-	0x73, 0x63, 0x61, 0x74, 0x50, 0x61, 0x72, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x65, 0x74, 0x43,
-	0x74, 0x46, 0x6f, 0x76, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x02,
-	0x52, 0x0e, 0x70, 0x65, 0x74, 0x43, 0x74, 0x46, 0x6f, 0x76, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x12, 0x28, 0x0a, 0x0f, 0x63, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e,
-	0x67, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x63, 0x74, 0x52, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x32,
-	0x0a, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x72, 0x65,
-	0x63, 0x6f, 0x6e, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x70,
-	0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x61,
-	0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64,
-	0x65, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2a, 0xa8, 0x01, 0x0a, 0x08, 0x46,
-	0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x61, 0x77, 0x44, 0x61,
-	0x74, 0x61, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65,
-	0x43, 0x6f, 0x69, 0x6e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x69, 0x63, 0x68, 0x10, 0x02,
-	0x12, 0x18, 0x0a, 0x14, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x69,
-	0x6d, 0x65, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70,
-	0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x53, 0x70, 0x65, 0x63,
-	0x74, 0x72, 0x75, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x6d,
-	0x67, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79,
-	0x4d, 0x61, 0x70, 0x10, 0x08, 0x2a, 0x21, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x12, 0x0b, 0x0a, 0x07, 0x44,
-	0x65, 0x66, 0x6c, 0x61, 0x74, 0x65, 0x10, 0x00, 0x42, 0x1e, 0x5a, 0x1c, 0x67, 0x69, 0x74, 0x6c,
-	0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x75, 0x69, 0x73, 0x32, 0x39, 0x36, 0x2f,
-	0x70, 0x65, 0x74, 0x2f, 0x64, 0x70, 0x65, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x73, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x64, 0x6f, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0e,
+	0x69, 0x73, 0x43, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x61, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x43, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x53, 0x63, 0x61, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x74, 0x42, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x65, 0x74, 0x42, 0x65, 0x64, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x74, 0x42, 0x65, 0x64, 0x4e, 0x75,
+	0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x65, 0x74, 0x42, 0x65, 0x64, 0x4e,
+	0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x74, 0x43, 0x74, 0x46, 0x69, 0x72, 0x73, 0x74,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x74, 0x43, 0x74, 0x46, 0x69, 0x72,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x4d, 0x6f, 0x64,
+	0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x74, 0x53, 0x63,
+	0x61, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x72, 0x65,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x41, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x61, 0x6e, 0x49, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x61, 0x6e,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x6f,
+	0x6c, 0x74, 0x61, 0x67, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x6f, 0x6c,
+	0x74, 0x61, 0x67, 0x65, 0x22, 0x83, 0x07, 0x0a, 0x0f, 0x41, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x66, 0x66,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x66, 0x66,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e,
+	0x75, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x6f, 0x74, 0x6f, 0x70, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x73, 0x6f, 0x74, 0x6f, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e,
+	0x6a, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a,
+	0x74, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0b, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x20, 0x0a, 0x0b,
+	0x78, 0x74, 0x61, 0x6c, 0x6b, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0b, 0x78, 0x74, 0x61, 0x6c, 0x6b, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x22,
+	0x0a, 0x0c, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x50, 0x45, 0x54, 0x43, 0x54, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x50, 0x45, 0x54, 0x43, 0x54, 0x53, 0x70, 0x61,
+	0x63, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x63, 0x61, 0x6e, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x50, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x12, 0x73, 0x63, 0x61, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x50, 0x65, 0x72, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x64, 0x79, 0x49, 0x44, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x75, 0x64, 0x79, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x78, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x78, 0x12, 0x24,
+	0x0a, 0x0d, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x70, 0x61, 0x74,
+	0x69, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x95, 0x0d, 0x0a, 0x0b, 0x53,
+	0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x58, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x58, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x59, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x59, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
+	0x75, 0x6d, 0x5a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4e, 0x75, 0x6d, 0x5a, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x69, 0x74,
+	0x63, 0x68, 0x58, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x69, 0x74, 0x63, 0x68, 0x58, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50,
+	0x69, 0x74, 0x63, 0x68, 0x59, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x50, 0x69, 0x74, 0x63, 0x68, 0x59, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x50, 0x69, 0x74, 0x63, 0x68, 0x5a, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x69, 0x74, 0x63, 0x68, 0x5a, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x58, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x58, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x59, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x59, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x5a, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x5a, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72,
+	0x79, 0x73, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x58, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x58, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x59, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x59, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x5a, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x5a,
+	0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61,
+	0x6c, 0x50, 0x69, 0x74, 0x63, 0x68, 0x58, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x63,
+	0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x50, 0x69, 0x74, 0x63, 0x68, 0x58, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x50, 0x69, 0x74, 0x63, 0x68, 0x59, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0d, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x50, 0x69, 0x74, 0x63,
+	0x68, 0x59, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x50, 0x69, 0x74,
+	0x63, 0x68, 0x5a, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x63, 0x72, 0x79, 0x73, 0x74,
+	0x61, 0x6c, 0x50, 0x69, 0x74, 0x63, 0x68, 0x5a, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x79, 0x73,
+	0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x58, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c,
+	0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x58, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x59, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0c, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x59,
+	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x5a,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x63, 0x72, 0x79, 0x73, 0x74, 0x61, 0x6c, 0x53,
+	0x69, 0x7a, 0x65, 0x5a, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x75,
+	0x6d, 0x58, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x4e, 0x75, 0x6d, 0x58, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x75,
+	0x6d, 0x59, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x4e, 0x75, 0x6d, 0x59, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x75,
+	0x6d, 0x5a, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x4e, 0x75, 0x6d, 0x5a, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x69,
+	0x74, 0x63, 0x68, 0x58, 0x18, 0x17, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x50, 0x69, 0x74, 0x63, 0x68, 0x58, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x50, 0x69, 0x74, 0x63, 0x68, 0x59, 0x18, 0x18, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x69, 0x74, 0x63, 0x68, 0x59, 0x12, 0x22, 0x0a, 0x0c,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x69, 0x74, 0x63, 0x68, 0x5a, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x69, 0x74, 0x63, 0x68, 0x5a,
+	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x58, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x58, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x59, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x59, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x5a, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x5a, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x4e,
+	0x75, 0x6d, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x4e,
+	0x75, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x50, 0x69, 0x74, 0x63, 0x68,
+	0x58, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x50, 0x69,
+	0x74, 0x63, 0x68, 0x58, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x50, 0x69, 0x74,
+	0x63, 0x68, 0x59, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x50, 0x69, 0x74, 0x63, 0x68, 0x59, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x50,
+	0x69, 0x74, 0x63, 0x68, 0x5a, 0x18, 0x20, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x50, 0x69, 0x74, 0x63, 0x68, 0x5a, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x58, 0x18, 0x21, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x58, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x59, 0x18, 0x22, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x59, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x5a, 0x18, 0x23, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x5a, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x25, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0d, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x64, 0x69,
+	0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x26, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x76, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x18, 0x29, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0d, 0x6d, 0x76, 0x74, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x76, 0x74, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x2a, 0x20, 0x03, 0x28, 0x02, 0x52,
+	0x0d, 0x6d, 0x76, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x61, 0x78, 0x69, 0x73, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x2b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x61, 0x78, 0x69, 0x73, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x2d,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x11, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x2f,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x30, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x69, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x31, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x32,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x22, 0xff, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x64, 0x4e, 0x75, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f,
+	0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6e,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x6e, 0x65, 0x72,
+	0x67, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x65, 0x72, 0x67,
+	0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x6d, 0x65, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x69,
+	0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x69, 0x6e,
+	0x67, 0x47, 0x70, 0x75, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x73, 0x69, 0x6e,
+	0x67, 0x47, 0x70, 0x75, 0x22, 0xf9, 0x05, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x52,
+	0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x43, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x43, 0x6f, 0x6c, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x6f, 0x77, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x11, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x77, 0x50, 0x69, 0x78,
+	0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x54, 0x68, 0x69, 0x63, 0x6b, 0x6e, 0x65, 0x73,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x6c,
+	0x69, 0x63, 0x65, 0x54, 0x68, 0x69, 0x63, 0x6b, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x26,
+	0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x66, 0x66, 0x4e, 0x75, 0x6d,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x44,
+	0x69, 0x66, 0x66, 0x4e, 0x75, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74,
+	0x4e, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x65,
+	0x74, 0x4e, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x74, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x12, 0x28,
+	0x0a, 0x0f, 0x61, 0x74, 0x74, 0x6e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x74, 0x74, 0x6e, 0x43, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x63, 0x61, 0x74,
+	0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x73, 0x63, 0x61, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x61, 0x74, 0x50, 0x61, 0x72, 0x61, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x02, 0x52, 0x08, 0x73, 0x63, 0x61, 0x74, 0x50, 0x61, 0x72, 0x61, 0x12, 0x26,
+	0x0a, 0x0e, 0x70, 0x65, 0x74, 0x43, 0x74, 0x46, 0x6f, 0x76, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0e, 0x70, 0x65, 0x74, 0x43, 0x74, 0x46, 0x6f, 0x76,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x74, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0f, 0x63, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x67, 0x6c, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x53, 0x6f, 0x66,
+	0x74, 0x77, 0x61, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x2a, 0xa8, 0x01, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x4d, 0x69, 0x63, 0x68, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79,
+	0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x10, 0x03,
+	0x12, 0x16, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x6e, 0x65, 0x72,
+	0x67, 0x79, 0x53, 0x70, 0x65, 0x63, 0x74, 0x72, 0x75, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x10, 0x05,
+	0x12, 0x07, 0x0a, 0x03, 0x49, 0x6d, 0x67, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09,
+	0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x4d, 0x61, 0x70, 0x10, 0x08, 0x2a, 0x5a, 0x0a, 0x12, 0x44,
+	0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x74, 0x61,
+	0x78, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x65, 0x66, 0x6c, 0x61, 0x74, 0x65, 0x10, 0x00, 0x12, 0x10,
+	0x0a, 0x0c, 0x55, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x10, 0x01,
+	0x12, 0x08, 0x0a, 0x04, 0x5a, 0x6c, 0x69, 0x62, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x7a,
+	0x69, 0x70, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x65, 0x66, 0x6c, 0x61, 0x74, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x10, 0x04, 0x42, 0x1e, 0x5a, 0x1c, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x75, 0x69, 0x73, 0x32, 0x39, 0x36, 0x2f, 0x70,
+	0x65, 0x74, 0x2f, 0x64, 0x70, 0x65, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1899,32 +2016,34 @@ func file_dpet_proto_rawDescGZIP() []byte {
 }
 
 var file_dpet_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_dpet_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_dpet_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_dpet_proto_goTypes = []interface{}{
 	(FileType)(0),           // 0: FileType
 	(DataTransferSyntax)(0), // 1: DataTransferSyntax
 	(*PetFileHeader)(nil),   // 2: PetFileHeader
 	(*PublicInfo)(nil),      // 3: PublicInfo
-	(*ScanInfo)(nil),        // 4: ScanInfo
-	(*AcquisitionInfo)(nil), // 5: AcquisitionInfo
-	(*ScannerInfo)(nil),     // 6: ScannerInfo
-	(*CoincidenceInfo)(nil), // 7: CoincidenceInfo
-	(*ImageInfo)(nil),       // 8: ImageInfo
+	(*DataBlock)(nil),       // 4: DataBlock
+	(*ScanInfo)(nil),        // 5: ScanInfo
+	(*AcquisitionInfo)(nil), // 6: AcquisitionInfo
+	(*ScannerInfo)(nil),     // 7: ScannerInfo
+	(*CoincidenceInfo)(nil), // 8: CoincidenceInfo
+	(*ImageInfo)(nil),       // 9: ImageInfo
 }
 var file_dpet_proto_depIdxs = []int32{
 	3, // 0: PetFileHeader.publicInfo:type_name -> PublicInfo
-	4, // 1: PetFileHeader.scanInfo:type_name -> ScanInfo
-	5, // 2: PetFileHeader.acquisitionInfo:type_name -> AcquisitionInfo
-	6, // 3: PetFileHeader.scannerInfo:type_name -> ScannerInfo
-	7, // 4: PetFileHeader.coincidenceInfo:type_name -> CoincidenceInfo
-	8, // 5: PetFileHeader.imageInfo:type_name -> ImageInfo
-	0, // 6: PublicInfo.fileType:type_name -> FileType
-	1, // 7: PublicInfo.dataTransferSyntax:type_name -> DataTransferSyntax
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	5, // 1: PetFileHeader.scanInfo:type_name -> ScanInfo
+	6, // 2: PetFileHeader.acquisitionInfo:type_name -> AcquisitionInfo
+	7, // 3: PetFileHeader.scannerInfo:type_name -> ScannerInfo
+	8, // 4: PetFileHeader.coincidenceInfo:type_name -> CoincidenceInfo
+	9, // 5: PetFileHeader.imageInfo:type_name -> ImageInfo
+	4, // 6: PetFileHeader.dataBlocks:type_name -> DataBlock
+	0, // 7: PublicInfo.fileType:type_name -> FileType
+	1, // 8: PublicInfo.dataTransferSyntax:type_name -> DataTransferSyntax
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_dpet_proto_init() }
@@ -1958,7 +2077,7 @@ func file_dpet_proto_init() {
 			}
 		}
 		file_dpet_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScanInfo); i {
+			switch v := v.(*DataBlock); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1970,7 +2089,7 @@ func file_dpet_proto_init() {
 			}
 		}
 		file_dpet_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcquisitionInfo); i {
+			switch v := v.(*ScanInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1982,7 +2101,7 @@ func file_dpet_proto_init() {
 			}
 		}
 		file_dpet_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScannerInfo); i {
+			switch v := v.(*AcquisitionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1994,7 +2113,7 @@ func file_dpet_proto_init() {
 			}
 		}
 		file_dpet_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CoincidenceInfo); i {
+			switch v := v.(*ScannerInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2006,6 +2125,18 @@ func file_dpet_proto_init() {
 			}
 		}
 		file_dpet_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoincidenceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dpet_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImageInfo); i {
 			case 0:
 				return &v.state
@@ -2024,7 +2155,7 @@ func file_dpet_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dpet_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,187 @@
+package dpet
+
+import (
+	"bufio"
+	"crypto/md5"
+	"encoding/hex"
+	"google.golang.org/protobuf/proto"
+	"hash"
+	"io"
+	"strings"
+)
+
+// Encoder 增量写出dpet文件：创建时写出文件头，之后按条或按批接收记录并实时压缩，
+// 全部记录写出后需调用Close结束数据区
+type Encoder struct {
+	header *Header
+
+	cw  io.WriteCloser
+	buf *bufio.Writer
+
+	// writer可Seek时，Close会回写文件头中的数据区md5值
+	seeker     io.WriteSeeker
+	headOffset int64
+	hash       hash.Hash
+
+	closed bool
+}
+
+// NewEncoder 向writer写出文件头并返回用于写出数据区的Encoder，Close不会关闭writer。
+// writer可Seek时（如普通文件），文件头中预留md5值，Close时回写数据区的实际md5值；
+// 否则（如管道、socket）无法回写，文件头中的md5值置空
+func NewEncoder(writer io.Writer, header *Header, opt ...WriteOption) (*Encoder, error) {
+	option := genWriteOption(opt...)
+	e := &Encoder{header: header}
+	if option.syntax != nil {
+		e.header = e.headerWithMD5(header.Content.GetPublicInfo().GetMD5())
+		option.apply(e.header.Content.PublicInfo)
+		header = e.header
+	}
+	cw, err := newCompressor(header.Content.GetPublicInfo().GetDataTransferSyntax(), option.level, writer)
+	if err != nil {
+		return nil, err
+	}
+	e.cw = cw
+	// 管道等*os.File同样实现了io.WriteSeeker，Seek失败时按不可Seek处理
+	if seeker, ok := writer.(io.WriteSeeker); ok {
+		if offset, err := seeker.Seek(0, io.SeekCurrent); err == nil {
+			e.seeker, e.headOffset, e.hash = seeker, offset, md5.New()
+		}
+	}
+	if e.seeker != nil {
+		// md5值为定长的32位十六进制字符串，预留同样长度的占位值以保证回写时文件头长度不变
+		header = e.headerWithMD5(strings.Repeat("0", md5.Size*2))
+	} else {
+		// 不能回写时清空md5值，避免沿用从其它文件复制来的文件头中的旧值
+		header = e.headerWithMD5("")
+	}
+	err = writeHead(header, writer)
+	if err != nil {
+		return nil, err
+	}
+	if e.hash != nil {
+		e.buf = bufio.NewWriter(io.MultiWriter(cw, e.hash))
+	} else {
+		e.buf = bufio.NewWriter(cw)
+	}
+	return e, nil
+}
+
+// WriteRawItems930 写出930原始数据记录
+func (e *Encoder) WriteRawItems930(items ...RawDataItem930) error {
+	if err := e.check(is930, FileType_RawData); err != nil {
+		return err
+	}
+	data := &RawData930{List: items}
+	if err := checkI30Range(e.header.Content, data); err != nil {
+		return err
+	}
+	return writeRawData930(data, e.buf)
+}
+
+// WriteListModeItems930 写出930符合信息记录
+func (e *Encoder) WriteListModeItems930(items ...ListModeDataItem930) error {
+	if err := e.check(is930, FileType_ListModeCoin); err != nil {
+		return err
+	}
+	data := &ListModeCoinData930{List: items}
+	if err := checkI30Range(e.header.Content, data); err != nil {
+		return err
+	}
+	return writeListModeCoinData930(data, e.buf)
+}
+
+// WriteMich930 写出930 mich计数值
+func (e *Encoder) WriteMich930(values ...uint16) error {
+	if err := e.check(is930, FileType_Mich); err != nil {
+		return err
+	}
+	return writeMichData930(values, e.buf)
+}
+
+// WriteBDMInfosE180 写出E180原始数据BDM信息块
+func (e *Encoder) WriteBDMInfosE180(infos ...*BDMInfo) error {
+	if err := e.check(isE180, FileType_RawData); err != nil {
+		return err
+	}
+	return writeRawDataE180(&RawDataE180{BDMInfos: infos}, e.buf)
+}
+
+// WriteCoinPairsE180 写出E180符合事件对
+func (e *Encoder) WriteCoinPairsE180(pairs ...CoinPair) error {
+	if err := e.check(isE180, FileType_ListModeCoin); err != nil {
+		return err
+	}
+	return writeListModeCoinDataE180(&ListModeCoinDataE180{CoinPairs: pairs}, e.buf)
+}
+
+// WriteMichE180 写出E180 mich值
+func (e *Encoder) WriteMichE180(values ...float32) error {
+	if err := e.check(isE180, FileType_Mich); err != nil {
+		return err
+	}
+	return writeMichDataE180(values, e.buf)
+}
+
+// Close 写出缓冲中的记录并结束压缩流，重复调用无副作用
+func (e *Encoder) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	if err := e.buf.Flush(); err != nil {
+		return err
+	}
+	if err := e.cw.Close(); err != nil {
+		return err
+	}
+	if e.seeker == nil {
+		return nil
+	}
+	return e.patchMD5()
+}
+
+// patchMD5 回到文件头位置写入数据区的md5值，完成后回到文件末尾
+func (e *Encoder) patchMD5() error {
+	head, err := marshalHead(e.headerWithMD5(hex.EncodeToString(e.hash.Sum(nil))))
+	if err != nil {
+		return err
+	}
+	end, err := e.seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err = e.seeker.Seek(e.headOffset, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err = e.seeker.Write(head); err != nil {
+		return err
+	}
+	_, err = e.seeker.Seek(end, io.SeekStart)
+	return err
+}
+
+// headerWithMD5 返回md5值替换为sum的文件头副本，不修改调用方传入的文件头
+func (e *Encoder) headerWithMD5(sum string) *Header {
+	header := &Header{
+		MarshalMethod: e.header.MarshalMethod,
+		Content:       proto.Clone(e.header.Content).(*PetFileHeader),
+	}
+	if header.Content.PublicInfo == nil {
+		header.Content.PublicInfo = &PublicInfo{}
+	}
+	header.Content.PublicInfo.MD5 = sum
+	return header
+}
+
+// check 检查Encoder是否可写，以及文件头中的设备及文件类型是否与所写出的记录一致
+func (e *Encoder) check(device func(string) bool, fileType FileType) error {
+	if e.closed {
+		return EncoderClosed
+	}
+	content := e.header.Content
+	if !device(content.GetScannerInfo().GetDevice()) || content.GetPublicInfo().GetFileType() != fileType {
+		return DataTypeMismatch
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package dpet
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestEncoder(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	e, err := NewEncoder(buf, testHeader(FileE180, FileType_ListModeCoin))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pairs []CoinPair
+	for i := 0; i < 10; i++ {
+		pair := CoinPair{
+			{GlobalCrystalIndex: uint32(i), Energy: 511, TimeValue: float64(i)},
+			{GlobalCrystalIndex: uint32(i + 100), Energy: 480, TimeValue: float64(i) + 0.5},
+		}
+		pairs = append(pairs, pair)
+	}
+	// 分批写出
+	if err = e.WriteCoinPairsE180(pairs[:4]...); err != nil {
+		t.Fatal(err)
+	}
+	if err = e.WriteCoinPairsE180(pairs[4:]...); err != nil {
+		t.Fatal(err)
+	}
+	if err = e.WriteMichE180(1); err != DataTypeMismatch {
+		t.Fatalf("expected DataTypeMismatch, got %v", err)
+	}
+	if err = e.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err = e.WriteCoinPairsE180(pairs...); err != EncoderClosed {
+		t.Fatalf("expected EncoderClosed, got %v", err)
+	}
+
+	r, err := NewReader(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	for i := range pairs {
+		pair, err := r.NextCoinPairE180()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(pair, pairs[i]) {
+			t.Fatalf("pair %d mismatch: %v %v", i, pair, pairs[i])
+		}
+	}
+	if _, err = r.NextCoinPairE180(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+}
+
+func TestEncoderMD5(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.dpet")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	e, err := NewEncoder(f, testHeader(FileE180, FileType_Mich))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = e.WriteMichE180(1, 2, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err = e.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err = Verify(path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEncoderPipe(t *testing.T) {
+	pr, pw, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pr.Close()
+	read := make(chan []byte)
+	go func() {
+		bs, _ := io.ReadAll(pr)
+		read <- bs
+	}()
+
+	// 从其它文件复制来的文件头带有旧的md5值
+	header := testHeader(FileE180, FileType_Mich)
+	header.Content.PublicInfo.MD5 = md5Hex([]byte("stale"))
+	e, err := NewEncoder(pw, header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = e.WriteMichE180(1, 2, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err = e.Close(); err != nil {
+		t.Fatal(err)
+	}
+	pw.Close()
+
+	parsed, err := Parse(bytes.NewBuffer(<-read))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Header.Content.PublicInfo.MD5 != "" || !reflect.DeepEqual(parsed.Data, []float32{1, 2, 3}) {
+		t.Fatalf("unexpected dataset: %v %v", parsed.Header.Content.PublicInfo, parsed.Data)
+	}
+	if header.Content.PublicInfo.MD5 != md5Hex([]byte("stale")) {
+		t.Fatal("NewEncoder should not modify header")
+	}
+}
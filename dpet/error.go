@@ -3,9 +3,26 @@ package dpet
 import "errors"
 
 var (
-	WrongFileTypeError   = errors.New("not dpet file or file damage")
-	UnmarshalError       = errors.New("cannot unmarshal file header content")
-	UnknownMarshalMethod = errors.New("unknown marshal method")
-	UnknownFileType      = errors.New("unknown file type")
-	UnknownDrive         = errors.New("unknown unknown drive")
+	WrongFileTypeError       = errors.New("not dpet file or file damage")
+	UnmarshalError           = errors.New("cannot unmarshal file header content")
+	UnknownMarshalMethod     = errors.New("unknown marshal method")
+	UnknownFileType          = errors.New("unknown file type")
+	UnknownDrive             = errors.New("unknown unknown drive")
+	DataTypeMismatch         = errors.New("data type does not match device or file type")
+	EncoderClosed            = errors.New("write to closed encoder")
+	MD5Mismatch              = errors.New("data area md5 mismatch")
+	MD5Missing               = errors.New("file header has no data area md5")
+	UnknownTransferSyntax    = errors.New("unknown data transfer syntax")
+	NotBlockSyntax           = errors.New("data transfer syntax is not DeflateBlocks")
+	BlockIndexError          = errors.New("invalid data block index")
+	RecordOutOfRange         = errors.New("record out of range")
+	BlockSyntaxNotStreamable = errors.New("DeflateBlocks cannot be written by streaming encoder")
+	PayloadSizeMismatch      = errors.New("data area size does not match scanner geometry or image size")
+	GeometryMissing          = errors.New("scanner geometry required to size data area is missing or invalid")
+	WriteVerifyMismatch      = errors.New("written file does not match input")
+	DataAreaHeaderChanged    = errors.New("header fields describing data area cannot be changed")
+	DataNotParsed            = errors.New("data area is not parsed")
+	DetectorOutOfRange       = errors.New("detector ip or channel out of scanner range")
+	BDMPacketError           = errors.New("invalid E180 bdm packet")
+	BDMFieldOutOfRange       = errors.New("E180 single field out of bdm packet range")
 )
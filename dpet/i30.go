@@ -0,0 +1,66 @@
+package dpet
+
+import (
+	"fmt"
+	"io"
+)
+
+// codecI30 i30的记录格式与930相同，但探测器数量及IP、通道布局不同，
+// 解析及写出时按文件头ScannerInfo中的IP及通道范围检查记录，见checkDetectorI30
+type codecI30 struct{}
+
+func (codecI30) Decode(r *Reader, fileType FileType) (interface{}, error) {
+	switch fileType {
+	case FileType_RawData, FileType_ListModeCoin, FileType_Mich:
+		// 逐条读取时已检查IP及通道号
+		return parseData930(r, fileType)
+	}
+	data, err := io.ReadAll(r.data)
+	if err != nil {
+		return nil, err
+	}
+	return decodePayload(r.header.Content, fileType, data)
+}
+
+func (codecI30) Encode(content *PetFileHeader, data interface{}, w io.Writer) error {
+	if err := checkI30Range(content, data); err != nil {
+		return err
+	}
+	return writeData930(content, data, w)
+}
+
+// checkI30Range 检查原始数据及符合信息中的全部记录，见checkDetectorI30
+func checkI30Range(content *PetFileHeader, data interface{}) error {
+	switch data := data.(type) {
+	case *RawData930:
+		for _, item := range data.List {
+			if err := checkDetectorI30(content, item.IP, 0, false); err != nil {
+				return err
+			}
+		}
+	case *ListModeCoinData930:
+		for _, item := range data.List {
+			if err := checkDetectorI30(content, item.IP, item.Channel, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkDetectorI30 检查i30文件中记录的IP及通道号是否在ScannerInfo描述的范围内，其它设备不检查。
+// i30的探测器布局尚无可引用的说明，因此不提供预设值：IpCounts或ChannelCounts未填写时不检查对应字段
+func checkDetectorI30(content *PetFileHeader, ip, channel uint16, checkChannel bool) error {
+	s := content.GetScannerInfo()
+	if s.GetDevice() != FileI30 {
+		return nil
+	}
+	inRange := func(v uint16, start, counts int32) bool {
+		return counts <= 0 || int32(v) >= start && int32(v) < start+counts
+	}
+	if !inRange(ip, s.GetIpStart(), s.GetIpCounts()) ||
+		checkChannel && !inRange(channel, s.GetChannelStart(), s.GetChannelCounts()) {
+		return fmt.Errorf("%w: ip %#04x channel %d", DetectorOutOfRange, ip, channel)
+	}
+	return nil
+}
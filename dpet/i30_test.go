@@ -0,0 +1,80 @@
+package dpet
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestI30(t *testing.T) {
+	header := testHeader(FileI30, FileType_ListModeCoin)
+	scanner := header.Content.ScannerInfo
+	scanner.IpStart, scanner.IpCounts, scanner.ChannelStart, scanner.ChannelCounts = 0x0101, 48, 0, 64
+	dataset := &Dataset{Header: header, Data: &ListModeCoinData930{List: []ListModeDataItem930{
+		{IP: 0x0101, Channel: 0}, {IP: 0x0130, Channel: 63},
+	}}}
+	buf := bytes.NewBuffer(nil)
+	if err := Write(dataset, buf, WriteVerified()); err != nil {
+		t.Fatal(err)
+	}
+
+	// 930的IP及通道号超出i30的范围
+	for _, item := range []ListModeDataItem930{{IP: 0x0131}, {IP: 0x0101, Channel: 64}} {
+		dataset.Data = &ListModeCoinData930{List: []ListModeDataItem930{item}}
+		if err := Write(dataset, bytes.NewBuffer(nil)); !errors.Is(err, DetectorOutOfRange) {
+			t.Fatalf("expected DetectorOutOfRange, got %v", err)
+		}
+		e, err := NewEncoder(bytes.NewBuffer(nil), header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = e.WriteListModeItems930(item); !errors.Is(err, DetectorOutOfRange) {
+			t.Fatalf("expected DetectorOutOfRange, got %v", err)
+		}
+	}
+
+	// 以i30文件头读取930数据
+	dataset.Header.Content.ScannerInfo.Device = File930
+	buf = bytes.NewBuffer(nil)
+	if err := Write(dataset, buf); err != nil {
+		t.Fatal(err)
+	}
+	i30 := bytes.NewBuffer(nil)
+	err := rewriteHeader(buf, i30, func(header *Header) error {
+		header.Content.ScannerInfo.Device = FileI30
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded := i30.Bytes()
+	if _, err = Parse(bytes.NewBuffer(encoded)); !errors.Is(err, DetectorOutOfRange) {
+		t.Fatalf("expected DetectorOutOfRange, got %v", err)
+	}
+	r, err := NewReader(bytes.NewReader(encoded))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if _, err = r.NextListModeItem930(); !errors.Is(err, DetectorOutOfRange) {
+		t.Fatalf("expected DetectorOutOfRange, got %v", err)
+	}
+}
+
+func TestI30Geometry(t *testing.T) {
+	header := testHeader(FileI30, FileType_ListModeCoin)
+	dataset := &Dataset{Header: header, Data: &ListModeCoinData930{List: []ListModeDataItem930{{IP: 2, Channel: 100}}}}
+	// 未填写范围时不检查
+	if err := Write(dataset, bytes.NewBuffer(nil), WriteVerified()); err != nil {
+		t.Fatal(err)
+	}
+	// 显式填写的零值起始地址不被替换
+	header.Content.ScannerInfo.IpStart, header.Content.ScannerInfo.IpCounts = 0, 4
+	if err := Write(dataset, bytes.NewBuffer(nil), WriteVerified()); err != nil {
+		t.Fatal(err)
+	}
+	header.Content.ScannerInfo.ChannelCounts = 64
+	if err := Write(dataset, bytes.NewBuffer(nil)); !errors.Is(err, DetectorOutOfRange) {
+		t.Fatalf("expected DetectorOutOfRange, got %v", err)
+	}
+}
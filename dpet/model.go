@@ -49,11 +49,15 @@ type RawData930 struct {
 	List []RawDataItem930
 }
 
+// RawDataItem930 930原始数据包，数据包内部布局尚无可引用的说明，Data按原始字节保存
 type RawDataItem930 struct {
 	Data []uint8
 	IP   uint16
 }
 
+// RawDataPacketLen930 930原始数据包长度
+const RawDataPacketLen930 = 1152
+
 // ListModeCoinDataE180 E180符合信息
 type ListModeCoinDataE180 struct {
 	CoinPairs []CoinPair
@@ -81,8 +85,48 @@ type ListModeDataItem930 struct {
 	Time     float64
 }
 
+// Image 重建图像，按层、行、列顺序存放，列变化最快
+type Image struct {
+	Rows   int
+	Cols   int
+	Slices int
+	Values []float32
+}
+
+// At 返回指定行、列、层的像素值
+func (img *Image) At(row, col, slice int) float32 {
+	return img.Values[(slice*img.Rows+row)*img.Cols+col]
+}
+
+// CalibrationMap 能量刻度表及时间刻度表，每个通道有Stride个系数。
+// 930按IP、通道顺序编号，其它设备按晶体编号
+type CalibrationMap struct {
+	Channels int
+	Stride   int
+	Values   []float32
+}
+
+// At 返回第channel个通道的系数
+func (m *CalibrationMap) At(channel int) []float32 {
+	return m.Values[channel*m.Stride : (channel+1)*m.Stride]
+}
+
+// EnergySpectrum 能谱，每个通道有Bins个计数，通道编号同CalibrationMap
+type EnergySpectrum struct {
+	Channels int
+	Bins     int
+	Counts   []uint32
+}
+
+// At 返回第channel个通道的能谱直方图
+func (s *EnergySpectrum) At(channel int) []uint32 {
+	return s.Counts[channel*s.Bins : (channel+1)*s.Bins]
+}
+
 const (
 	MarshallMethodProto = iota
+	// MarshallMethodJSON 文件头以protojson格式存放，可直接用文本编辑器查看及修改
+	MarshallMethodJSON
 )
 
 const (
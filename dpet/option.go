@@ -1,8 +1,13 @@
 package dpet
 
+import "compress/flate"
+
 type ParseOptionSet struct {
 	notParseData bool
 	onlyHeader   bool
+	verifyMD5    bool
+	// 不兼容以Flush结束的deflate数据区
+	strictDeflate bool
 }
 
 type ParseOption func(*ParseOptionSet)
@@ -28,3 +33,76 @@ func OnlyParseHeader() ParseOption {
 		set.onlyHeader = true
 	}
 }
+
+// VerifyMD5 读取数据区时计算其md5值，并在数据区结束时与文件头中的PublicInfo.MD5比较
+func VerifyMD5() ParseOption {
+	return func(set *ParseOptionSet) {
+		set.verifyMD5 = true
+	}
+}
+
+// StrictDeflate deflate数据区必须以结束块结尾，否则返回io.ErrUnexpectedEOF。
+// 默认兼容早期版本Write写出的以Flush而非Close结束的数据区：仅当输入恰好结束于Flush产生的同步标记时视为数据区正常结束，
+// 其它位置的截断仍返回io.ErrUnexpectedEOF
+func StrictDeflate() ParseOption {
+	return func(set *ParseOptionSet) {
+		set.strictDeflate = true
+	}
+}
+
+type WriteOptionSet struct {
+	syntax       *DataTransferSyntax
+	level        int
+	blockRecords int
+	verify       bool
+}
+
+type WriteOption func(*WriteOptionSet)
+
+func genWriteOption(opts ...WriteOption) *WriteOptionSet {
+	option := &WriteOptionSet{level: flate.DefaultCompression, blockRecords: DefaultBlockRecords}
+	for _, opt := range opts {
+		opt(option)
+	}
+	return option
+}
+
+// WithTransferSyntax 指定数据区的传输语义，写出的文件头中的DataTransferSyntax随之修改；
+// 未指定时使用文件头中的传输语义
+func WithTransferSyntax(syntax DataTransferSyntax) WriteOption {
+	return func(set *WriteOptionSet) {
+		set.syntax = &syntax
+	}
+}
+
+// WithCompressionLevel 指定压缩级别，取值同compress/flate，对Uncompressed无效
+func WithCompressionLevel(level int) WriteOption {
+	return func(set *WriteOptionSet) {
+		set.level = level
+	}
+}
+
+// WithBlockRecords 指定DeflateBlocks传输语义下每块包含的记录数，默认为DefaultBlockRecords
+func WithBlockRecords(records int) WriteOption {
+	return func(set *WriteOptionSet) {
+		if records > 0 {
+			set.blockRecords = records
+		}
+	}
+}
+
+// WriteVerified 先将文件写入内存并重新解析，文件头、数据区及解析得到的数据均与输入一致时才写出，
+// 否则返回WriteVerifyMismatch且不写出任何内容。
+// 比较Data时使用reflect.DeepEqual，输入中的空切片须与解析结果一样为nil
+func WriteVerified() WriteOption {
+	return func(set *WriteOptionSet) {
+		set.verify = true
+	}
+}
+
+// apply 将指定的传输语义写入文件头
+func (set *WriteOptionSet) apply(info *PublicInfo) {
+	if set.syntax != nil {
+		info.DataTransferSyntax = *set.syntax
+	}
+}
@@ -0,0 +1,172 @@
+package dpet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// parsePayload 整体读取数据区并解析刻度表、能谱、图像等非记录型数据
+func parsePayload(r *Reader, fileType FileType) (interface{}, error) {
+	data, err := io.ReadAll(r.data)
+	if err != nil {
+		return nil, err
+	}
+	return decodePayload(r.header.Content, fileType, data)
+}
+
+// decodePayload 按文件类型解析非记录型数据区。图像与dpetk的ImageData相同，为按层、行、列存放的float32体数据；
+// 刻度表及能谱与dpetk的CalibrationMap、EnergySpectrum相同，按通道存放。
+// 位置查找表及能量峰位表的数据区布局未知，返回UnknownFileType，可通过NotParseData读取原始数据区
+func decodePayload(content *PetFileHeader, fileType FileType, data []byte) (interface{}, error) {
+	switch fileType {
+	case FileType_Img:
+		// 尺寸来自文件头，须先与数据区长度核对再分配内存
+		rows, cols, slices := imageSize(content)
+		l, ok := dimProduct(rows, cols, slices, 4)
+		if !ok || l != len(data) {
+			return nil, PayloadSizeMismatch
+		}
+		values := make([]float32, l/4)
+		if err := decodeValues(data, values); err != nil {
+			return nil, err
+		}
+		return &Image{Rows: rows, Cols: cols, Slices: slices, Values: values}, nil
+	case FileType_EnergyCalibrationMap, FileType_TimeCalibrationMap:
+		channels, stride, err := channelStride(content, len(data))
+		if err != nil {
+			return nil, err
+		}
+		values := make([]float32, channels*stride)
+		if err = decodeValues(data, values); err != nil {
+			return nil, err
+		}
+		return &CalibrationMap{Channels: channels, Stride: stride, Values: values}, nil
+	case FileType_EnergySpectrumData:
+		channels, bins, err := channelStride(content, len(data))
+		if err != nil {
+			return nil, err
+		}
+		counts := make([]uint32, channels*bins)
+		if err = decodeValues(data, counts); err != nil {
+			return nil, err
+		}
+		return &EnergySpectrum{Channels: channels, Bins: bins, Counts: counts}, nil
+	}
+	return nil, UnknownFileType
+}
+
+// writePayload 写出刻度表、能谱、图像等非记录型数据，尺寸须与文件头中的几何信息或图像尺寸一致，布局见decodePayload
+func writePayload(content *PetFileHeader, fileType FileType, data interface{}, w io.Writer) error {
+	var values interface{}
+	var size bool
+	switch fileType {
+	case FileType_Img:
+		img, ok := data.(*Image)
+		if !ok {
+			return DataTypeMismatch
+		}
+		rows, cols, slices := imageSize(content)
+		n, ok := dimProduct(rows, cols, slices)
+		values = img.Values
+		size = ok && img.Rows == rows && img.Cols == cols && img.Slices == slices && len(img.Values) == n
+	case FileType_EnergyCalibrationMap, FileType_TimeCalibrationMap:
+		m, ok := data.(*CalibrationMap)
+		if !ok {
+			return DataTypeMismatch
+		}
+		channels, err := channelCount(content)
+		if err != nil {
+			return err
+		}
+		values = m.Values
+		size = m.Channels == channels && len(m.Values) == m.Channels*m.Stride
+	case FileType_EnergySpectrumData:
+		spectrum, ok := data.(*EnergySpectrum)
+		if !ok {
+			return DataTypeMismatch
+		}
+		channels, err := channelCount(content)
+		if err != nil {
+			return err
+		}
+		values = spectrum.Counts
+		size = spectrum.Channels == channels && len(spectrum.Counts) == spectrum.Channels*spectrum.Bins
+	default:
+		return UnknownFileType
+	}
+	if !size {
+		return PayloadSizeMismatch
+	}
+	return binary.Write(w, binary.LittleEndian, values)
+}
+
+// decodeValues 按小端序将data解析到定长切片values中，data的长度须与values一致
+func decodeValues(data []byte, values interface{}) error {
+	if len(data) != binary.Size(values) {
+		return PayloadSizeMismatch
+	}
+	return binary.Read(bytes.NewReader(data), binary.LittleEndian, values)
+}
+
+func imageSize(content *PetFileHeader) (rows, cols, slices int) {
+	info := content.GetImageInfo()
+	return int(info.GetImageSizeRows()), int(info.GetImageSizeCols()), int(info.GetImageSizeSlices())
+}
+
+// channelCount 刻度表及能谱的通道数，930为IP数与每个IP的通道数之积，其它设备为晶体总数
+func channelCount(content *PetFileHeader) (int, error) {
+	s := content.GetScannerInfo()
+	var channels int
+	var ok bool
+	if is930(s.GetDevice()) {
+		channels, ok = dimProduct(int(s.GetIpCounts()), int(s.GetChannelCounts()))
+	} else if blocks, valid := blockCount(s); valid {
+		channels, ok = dimProduct(blocks, int(s.GetCrystalNumX()), int(s.GetCrystalNumY()), int(s.GetCrystalNumZ()))
+	}
+	if !ok {
+		return 0, GeometryMissing
+	}
+	return channels, nil
+}
+
+// channelStride 返回通道数及由数据区长度推算的每通道4字节元素个数
+func channelStride(content *PetFileHeader, l int) (channels, stride int, err error) {
+	channels, err = channelCount(content)
+	if err != nil {
+		return 0, 0, err
+	}
+	unit, ok := dimProduct(channels, 4)
+	if !ok || l%unit != 0 {
+		return 0, 0, PayloadSizeMismatch
+	}
+	return channels, l / unit, nil
+}
+
+// blockCount block总数，未填写的面板、模块及block数按1计，总数溢出时返回false
+func blockCount(s *ScannerInfo) (int, bool) {
+	var dims []int
+	for _, v := range []int32{
+		s.GetPanelNum(),
+		s.GetModuleNumX(), s.GetModuleNumY(), s.GetModuleNumZ(),
+		s.GetBlockNumX(), s.GetBlockNumY(), s.GetBlockNumZ(),
+	} {
+		if v > 0 {
+			dims = append(dims, int(v))
+		}
+	}
+	return dimProduct(dims...)
+}
+
+// dimProduct 各维度之积。维度来自文件头，不可信，任一维度不为正数或乘积溢出时返回false
+func dimProduct(dims ...int) (int, bool) {
+	n := 1
+	for _, d := range dims {
+		if d <= 0 || n > math.MaxInt/d {
+			return 0, false
+		}
+		n *= d
+	}
+	return n, true
+}
@@ -0,0 +1,112 @@
+package dpet
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestPayload(t *testing.T) {
+	header930 := func(fileType FileType) *Header {
+		header := testHeader(File930, fileType)
+		header.Content.ScannerInfo.IpCounts, header.Content.ScannerInfo.ChannelCounts = 2, 3
+		return header
+	}
+	headerE180 := func(fileType FileType) *Header {
+		header := testHeader(FileE180, fileType)
+		scanner := header.Content.ScannerInfo
+		scanner.ModuleNumZ, scanner.BlockNumX = 2, 2
+		scanner.CrystalNumX, scanner.CrystalNumY, scanner.CrystalNumZ = 2, 2, 1
+		return header
+	}
+	img := testHeader(FileE180, FileType_Img)
+	img.Content.ImageInfo = &ImageInfo{ImageSizeRows: 2, ImageSizeCols: 3, ImageSizeSlices: 4}
+	imgData := &Image{Rows: 2, Cols: 3, Slices: 4, Values: make([]float32, 24)}
+	for i := range imgData.Values {
+		imgData.Values[i] = float32(i)
+	}
+
+	cases := []*Dataset{
+		{Header: img, Data: imgData},
+		{Header: header930(FileType_EnergyCalibrationMap), Data: &CalibrationMap{Channels: 6, Stride: 2, Values: make([]float32, 12)}},
+		{Header: header930(FileType_TimeCalibrationMap), Data: &CalibrationMap{Channels: 6, Stride: 1, Values: make([]float32, 6)}},
+		{Header: headerE180(FileType_EnergySpectrumData), Data: &EnergySpectrum{Channels: 16, Bins: 3, Counts: make([]uint32, 48)}},
+	}
+	for _, dataset := range cases {
+		fileType := dataset.Header.Content.PublicInfo.FileType
+		buf := bytes.NewBuffer(nil)
+		if err := Write(dataset, buf); err != nil {
+			t.Fatalf("%v: %v", fileType, err)
+		}
+		parsed, err := Parse(buf)
+		if err != nil {
+			t.Fatalf("%v: %v", fileType, err)
+		}
+		if !reflect.DeepEqual(parsed.Data, dataset.Data) {
+			t.Fatalf("%v: round trip mismatch: %+v", fileType, parsed.Data)
+		}
+	}
+	if v := imgData.At(1, 2, 3); v != 23 {
+		t.Fatalf("unexpected pixel: %v", v)
+	}
+
+	// 布局未知的文件类型只能以原始数据区读写
+	for _, fileType := range []FileType{FileType_PositionTable, FileType_EnergyMap} {
+		dataset := &Dataset{Header: headerE180(fileType), Data: &CalibrationMap{Channels: 16, Stride: 1, Values: make([]float32, 16)}}
+		if err := Write(dataset, bytes.NewBuffer(nil)); err != UnknownFileType {
+			t.Fatalf("%v: expected UnknownFileType, got %v", fileType, err)
+		}
+		dataset.DataBuf = bytes.NewBuffer(make([]byte, 64))
+		buf := bytes.NewBuffer(nil)
+		if err := Write(dataset, buf); err != nil {
+			t.Fatal(err)
+		}
+		encoded := buf.Bytes()
+		if _, err := Parse(bytes.NewBuffer(encoded)); err != UnknownFileType {
+			t.Fatalf("%v: expected UnknownFileType, got %v", fileType, err)
+		}
+		parsed, err := Parse(bytes.NewBuffer(encoded), NotParseData())
+		if err != nil || parsed.DataBuf.Len() != 64 {
+			t.Fatalf("%v: unexpected result: %v", fileType, err)
+		}
+	}
+
+	// 尺寸与文件头不一致
+	dataset := &Dataset{Header: header930(FileType_EnergySpectrumData), Data: &EnergySpectrum{Channels: 5, Bins: 1, Counts: make([]uint32, 5)}}
+	if err := Write(dataset, bytes.NewBuffer(nil)); err != PayloadSizeMismatch {
+		t.Fatalf("expected PayloadSizeMismatch, got %v", err)
+	}
+	dataset.DataBuf = bytes.NewBuffer(make([]byte, 5*4))
+	buf := bytes.NewBuffer(nil)
+	if err := Write(dataset, buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Parse(buf); err != PayloadSizeMismatch {
+		t.Fatalf("expected PayloadSizeMismatch, got %v", err)
+	}
+}
+
+func TestPayloadInvalidSize(t *testing.T) {
+	img := testHeader(FileE180, FileType_Img)
+	spectrum := testHeader(File930, FileType_EnergySpectrumData)
+	spectrum.Content.ScannerInfo.IpCounts, spectrum.Content.ScannerInfo.ChannelCounts = -2, 3
+	for _, size := range [][3]int32{{-1, 2, 3}, {0, 2, 3}, {math.MaxInt32, math.MaxInt32, math.MaxInt32}, {-1, -2, 3}} {
+		img.Content.ImageInfo = &ImageInfo{ImageSizeRows: size[0], ImageSizeCols: size[1], ImageSizeSlices: size[2]}
+		dataset := &Dataset{Header: img, DataBuf: bytes.NewBuffer(make([]byte, 24))}
+		buf := bytes.NewBuffer(nil)
+		if err := Write(dataset, buf, WithTransferSyntax(DataTransferSyntax_Uncompressed)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Parse(buf); err != PayloadSizeMismatch {
+			t.Fatalf("%v: expected PayloadSizeMismatch, got %v", size, err)
+		}
+	}
+	buf := bytes.NewBuffer(nil)
+	if err := Write(&Dataset{Header: spectrum, DataBuf: bytes.NewBuffer(make([]byte, 24))}, buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Parse(buf); err != GeometryMissing {
+		t.Fatalf("expected GeometryMissing, got %v", err)
+	}
+}
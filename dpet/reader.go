@@ -1,10 +1,16 @@
 package dpet
 
 import (
+	"bufio"
 	"bytes"
-	"compress/flate"
+	"crypto/md5"
 	"encoding/binary"
+	"encoding/hex"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"hash"
+	"io"
+	"math"
 	"os"
 )
 
@@ -13,195 +19,524 @@ func ParseFile(path string, opt ...ParseOption) (*Dataset, error) {
 	if err != nil {
 		return nil, err
 	}
-	buf := bytes.NewBuffer(nil)
-	_, err = buf.ReadFrom(f)
+	defer f.Close()
+	return parse(f, opt...)
+}
+
+func Parse(buf *bytes.Buffer, opt ...ParseOption) (*Dataset, error) {
+	return parse(buf, opt...)
+}
+
+func parse(reader io.Reader, opt ...ParseOption) (*Dataset, error) {
+	option := genParseOption(opt...)
+
+	r, err := NewReader(reader, opt...)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	dataset := &Dataset{Header: r.Header()}
+
+	if option.onlyHeader {
+		return dataset, nil
+	}
+
+	if option.notParseData {
+		dataset.DataBuf = bytes.NewBuffer(nil)
+		_, err = dataset.DataBuf.ReadFrom(r.data)
+		if err != nil {
+			return nil, err
+		}
+		return dataset, nil
+	}
+	// 未注册编解码器的设备不解析数据区
+	if codec, ok := LookupCodec(dataset.Header.Content.ScannerInfo.Device); ok {
+		dataset.Data, err = codec.Decode(r, dataset.Header.Content.PublicInfo.FileType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return dataset, nil
+}
+
+// Reader dpet文件流式读取器，读取文件头后以流的形式解压数据区，
+// 按设备及文件类型逐条读取记录，不会将文件或数据区整体载入内存
+type Reader struct {
+	header *Header
+
+	// 解压后的数据区
+	data     *bufio.Reader
+	inflater io.ReadCloser
+	closer   io.Closer
+
+	// 读取定长记录时复用的缓冲区
+	record []byte
+}
+
+// Verify 读取整个文件并校验数据区的md5值
+func Verify(path string) error {
+	r, err := OpenFile(path, VerifyMD5())
 	if err != nil {
+		return err
+	}
+	defer r.Close()
+	_, err = io.Copy(io.Discard, r.Data())
+	return err
+}
+
+// OpenFile 打开文件并读取文件头，使用完毕后需调用Close
+func OpenFile(path string, opt ...ParseOption) (*Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewReader(f, opt...)
+	if err != nil {
+		f.Close()
 		return nil, err
 	}
-	return Parse(buf, opt...)
+	r.closer = f
+	return r, nil
 }
 
-func Parse(buf *bytes.Buffer, opt ...ParseOption) (*Dataset, error) {
+// NewReader 从reader中读取文件头，数据区留待Next系列方法读取。
+// 开启VerifyMD5时，数据区的校验结果在读取到数据区末尾时代替io.EOF返回
+func NewReader(reader io.Reader, opt ...ParseOption) (*Reader, error) {
 	option := genParseOption(opt...)
 
-	magicNumber := buf.Next(4)
-	dataset := &Dataset{}
-	if string(magicNumber) != string(MagicNumber) {
+	br := bufio.NewReader(reader)
+	header, err := readHead(br)
+	if err != nil {
+		return nil, err
+	}
+	var source io.Reader = br
+	var tail *tailReader
+	if !option.strictDeflate && header.Content.GetPublicInfo().GetDataTransferSyntax() == DataTransferSyntax_Deflate {
+		tail = &tailReader{reader: br}
+		source = tail
+	}
+	inflater, err := newDecompressor(header.Content, source)
+	if err != nil {
+		return nil, err
+	}
+	var data io.Reader = inflater
+	if tail != nil {
+		data = &flushedStream{reader: inflater, tail: tail}
+	}
+	if option.verifyMD5 {
+		data = &md5Stream{
+			reader:   data,
+			hash:     md5.New(),
+			expected: header.Content.GetPublicInfo().GetMD5(),
+		}
+	}
+	return &Reader{
+		header:   header,
+		data:     bufio.NewReader(data),
+		inflater: inflater,
+	}, nil
+}
+
+// md5Stream 在读取过程中计算md5值，读取到末尾时与文件头中的值比较，不一致时以MD5Mismatch代替io.EOF
+type md5Stream struct {
+	reader   io.Reader
+	hash     hash.Hash
+	expected string
+}
+
+func (s *md5Stream) Read(p []byte) (int, error) {
+	n, err := s.reader.Read(p)
+	s.hash.Write(p[:n])
+	if err == io.EOF {
+		switch {
+		case s.expected == "":
+			err = MD5Missing
+		case hex.EncodeToString(s.hash.Sum(nil)) != s.expected:
+			err = MD5Mismatch
+		}
+	}
+	return n, err
+}
+
+// syncMarker Flush在deflate流中写出的空存储块的长度字段，位于流的字节边界上
+var syncMarker = [4]byte{0x00, 0x00, 0xff, 0xff}
+
+// flushedStream 兼容以Flush而非Close结束的deflate流，此类数据区没有结束块，
+// 解压到输入末尾时会返回io.ErrUnexpectedEOF，输入结束于同步标记时视为数据区正常结束
+type flushedStream struct {
+	reader io.Reader
+	tail   *tailReader
+}
+
+func (s *flushedStream) Read(p []byte) (int, error) {
+	n, err := s.reader.Read(p)
+	if err == io.ErrUnexpectedEOF && s.tail.tail == syncMarker {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// tailReader 记录解压器已读取的最后4个字节。实现了io.ByteReader，flate不会再对其缓冲，
+// 因此记录的即是压缩流实际消耗的末尾字节
+type tailReader struct {
+	reader *bufio.Reader
+	tail   [4]byte
+}
+
+func (r *tailReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	for _, b := range p[:n] {
+		r.push(b)
+	}
+	return n, err
+}
+
+func (r *tailReader) ReadByte() (byte, error) {
+	b, err := r.reader.ReadByte()
+	if err == nil {
+		r.push(b)
+	}
+	return b, err
+}
+
+func (r *tailReader) push(b byte) {
+	copy(r.tail[:], r.tail[1:])
+	r.tail[3] = b
+}
+
+func readHead(reader io.Reader) (*Header, error) {
+	magicNumber := make([]byte, len(MagicNumber))
+	_, err := io.ReadFull(reader, magicNumber)
+	if err != nil || string(magicNumber) != string(MagicNumber) {
 		return nil, WrongFileTypeError
 	}
 	header := &Header{}
-	header.MarshalMethod = binary.LittleEndian.Uint16(buf.Next(2))
+	err = binary.Read(reader, binary.LittleEndian, &header.MarshalMethod)
+	if err != nil {
+		return nil, WrongFileTypeError
+	}
+	if header.MarshalMethod != MarshallMethodProto && header.MarshalMethod != MarshallMethodJSON {
+		return nil, UnknownMarshalMethod
+	}
+	err = binary.Read(reader, binary.LittleEndian, &header.DataLen)
+	if err != nil {
+		return nil, WrongFileTypeError
+	}
+	// DataLen来自文件，逐步读取以免损坏的文件导致按其申请内存
+	buf := bytes.NewBuffer(nil)
+	if _, err = io.CopyN(buf, reader, int64(header.DataLen)); err != nil {
+		return nil, WrongFileTypeError
+	}
+	content := buf.Bytes()
+	header.Content = &PetFileHeader{}
 	switch header.MarshalMethod {
 	case MarshallMethodProto:
-		header.DataLen = binary.LittleEndian.Uint32(buf.Next(4))
-		header.Content = &PetFileHeader{}
-		err := proto.Unmarshal(buf.Next(int(header.DataLen)), header.Content)
+		err = proto.Unmarshal(content, header.Content)
+	case MarshallMethodJSON:
+		err = protojson.Unmarshal(content, header.Content)
+	}
+	if err != nil {
+		return nil, UnmarshalError
+	}
+	return header, nil
+}
+
+// Header 返回文件头
+func (r *Reader) Header() *Header {
+	return r.header
+}
+
+// Data 返回解压后的数据区流，与Next系列方法共享读取位置
+func (r *Reader) Data() io.Reader {
+	return r.data
+}
+
+// Close 释放解压器，并关闭由OpenFile打开的文件
+func (r *Reader) Close() error {
+	err := r.inflater.Close()
+	if r.closer != nil {
+		if closeErr := r.closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// NextRawItem930 读取下一条930原始数据记录，数据区结束时返回io.EOF
+func (r *Reader) NextRawItem930() (RawDataItem930, error) {
+	if err := r.check(is930, FileType_RawData); err != nil {
+		return RawDataItem930{}, err
+	}
+	record, err := r.nextRecord(RawDataPacketLen930 + 2)
+	if err != nil {
+		return RawDataItem930{}, err
+	}
+	data := make([]uint8, RawDataPacketLen930)
+	copy(data, record)
+	item := RawDataItem930{
+		Data: data,
+		IP:   binary.LittleEndian.Uint16(record[RawDataPacketLen930:]),
+	}
+	if err = checkDetectorI30(r.header.Content, item.IP, 0, false); err != nil {
+		return RawDataItem930{}, err
+	}
+	return item, nil
+}
+
+// NextListModeItem930 读取下一条930符合信息记录，数据区结束时返回io.EOF
+func (r *Reader) NextListModeItem930() (ListModeDataItem930, error) {
+	if err := r.check(is930, FileType_ListModeCoin); err != nil {
+		return ListModeDataItem930{}, err
+	}
+	record, err := r.nextRecord(2 + 2 + 4 + 8)
+	if err != nil {
+		return ListModeDataItem930{}, err
+	}
+	item := decodeListModeItem930(record)
+	if err = checkDetectorI30(r.header.Content, item.IP, item.Channel, true); err != nil {
+		return ListModeDataItem930{}, err
+	}
+	return item, nil
+}
+
+// decodeListModeItem930 解析一条930符合信息记录
+func decodeListModeItem930(record []byte) ListModeDataItem930 {
+	ch := binary.LittleEndian.Uint16(record[2:])
+	return ListModeDataItem930{
+		IP:       binary.LittleEndian.Uint16(record),
+		XTalk:    ch&(1<<15) != 0,
+		Reserved: uint8((ch >> 12) & (1<<3 - 1)),
+		Channel:  ch & (1<<12 - 1),
+		Energy:   math.Float32frombits(binary.LittleEndian.Uint32(record[4:])),
+		Time:     math.Float64frombits(binary.LittleEndian.Uint64(record[8:])),
+	}
+}
+
+// NextMich930 读取下一个930 mich计数值，数据区结束时返回io.EOF
+func (r *Reader) NextMich930() (uint16, error) {
+	if err := r.check(is930, FileType_Mich); err != nil {
+		return 0, err
+	}
+	record, err := r.nextRecord(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(record), nil
+}
+
+// NextBDMInfoE180 读取下一个E180原始数据BDM信息块，数据区结束时返回io.EOF
+func (r *Reader) NextBDMInfoE180() (*BDMInfo, error) {
+	if err := r.check(isE180, FileType_RawData); err != nil {
+		return nil, err
+	}
+	record, err := r.nextRecord(1 + 2 + 2 + 1 + 1 + 4)
+	if err != nil {
+		return nil, err
+	}
+	info := &BDMInfo{
+		BDMIndex:   record[0],
+		IP:         binary.LittleEndian.Uint16(record[1:]),
+		Port:       binary.LittleEndian.Uint16(record[3:]),
+		GroupNum:   record[5],
+		GroupIndex: record[6],
+		DataLen:    binary.LittleEndian.Uint32(record[7:]),
+	}
+	for i := 0; i < (int(info.DataLen) / BDMInfoBodyByteLen); i++ {
+		body, err := r.nextRecord(BDMInfoBodyByteLen)
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
 		if err != nil {
-			return nil, UnmarshalError
+			return nil, err
 		}
-	default:
-		return nil, UnknownMarshalMethod
+		item := &BDMInfoBody{
+			HeadAndDU:          body[0],
+			BDM:                body[1],
+			Time:               append([]uint8(nil), body[2:10]...),
+			X:                  body[10],
+			Y:                  body[11],
+			Energy:             append([]uint8(nil), body[12:14]...),
+			TemperatureInt:     int8(body[14]),
+			TemperatureAndTail: body[15],
+		}
+		info.Content = append(info.Content, item)
 	}
-	dataset.Header = header
+	return info, nil
+}
 
-	if option.onlyHeader {
-		return dataset, nil
+// NextCoinPairE180 读取下一个E180符合事件对，数据区结束时返回io.EOF
+func (r *Reader) NextCoinPairE180() (CoinPair, error) {
+	if err := r.check(isE180, FileType_ListModeCoin); err != nil {
+		return CoinPair{}, err
 	}
+	record, err := r.nextRecord(2 * (4 + 4 + 8))
+	if err != nil {
+		return CoinPair{}, err
+	}
+	var pair CoinPair
+	for i := range pair {
+		item := record[i*16:]
+		pair[i] = &CoinInfo{
+			GlobalCrystalIndex: binary.LittleEndian.Uint32(item),
+			Energy:             math.Float32frombits(binary.LittleEndian.Uint32(item[4:])),
+			TimeValue:          math.Float64frombits(binary.LittleEndian.Uint64(item[8:])),
+		}
+	}
+	return pair, nil
+}
 
-	// deflate解压
-	fr := flate.NewReader(buf)
-	decompressBuf := bytes.NewBuffer(nil)
-	decompressBuf.ReadFrom(fr)
+// NextMichE180 读取下一个E180 mich值，数据区结束时返回io.EOF
+func (r *Reader) NextMichE180() (float32, error) {
+	if err := r.check(isE180, FileType_Mich); err != nil {
+		return 0, err
+	}
+	record, err := r.nextRecord(4)
+	if err != nil {
+		return 0, err
+	}
+	return math.Float32frombits(binary.LittleEndian.Uint32(record)), nil
+}
 
-	if option.notParseData {
-		dataset.DataBuf = decompressBuf
-		return dataset, nil
+func is930(device string) bool {
+	return device == File930 || device == FileI30
+}
+
+func isE180(device string) bool {
+	return device == FileE180
+}
+
+// check 检查文件的设备及文件类型是否与所读取的记录一致
+func (r *Reader) check(device func(string) bool, fileType FileType) error {
+	content := r.header.Content
+	if !device(content.GetScannerInfo().GetDevice()) || content.GetPublicInfo().GetFileType() != fileType {
+		return DataTypeMismatch
 	}
-	switch header.Content.ScannerInfo.Device {
-	case FileI30:
-		fallthrough
-	case File930:
-		dataset.Data = parseData930(decompressBuf, header.Content.PublicInfo.FileType)
-	case FileE180:
-		dataset.Data = parseDataE180(decompressBuf, header.Content.PublicInfo.FileType)
+	return nil
+}
+
+// nextRecord 读取一条长度为l的记录，数据区正常结束时返回io.EOF，末尾记录不完整时返回io.ErrUnexpectedEOF
+func (r *Reader) nextRecord(l int) ([]byte, error) {
+	if cap(r.record) < l {
+		r.record = make([]byte, l)
 	}
-	return dataset, nil
+	record := r.record[:l]
+	_, err := io.ReadFull(r.data, record)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
 }
 
-func parseData930(buf *bytes.Buffer, fileType FileType) interface{} {
+func parseData930(r *Reader, fileType FileType) (interface{}, error) {
 	switch fileType {
 	case FileType_RawData:
-		return parseRawData930(buf)
+		return parseRawData930(r)
 	case FileType_ListModeCoin:
-		return parseListModeCoinData930(buf)
+		return parseListModeCoinData930(r)
 	case FileType_Mich:
-		return parseMichData930(buf)
+		return parseMichData930(r)
 	}
-	return nil
+	return parsePayload(r, fileType)
 }
 
-func parseDataE180(buf *bytes.Buffer, fileType FileType) interface{} {
+func parseDataE180(r *Reader, fileType FileType) (interface{}, error) {
 	switch fileType {
 	case FileType_RawData:
-		return parseRawDataE180(buf)
+		return parseRawDataE180(r)
 	case FileType_ListModeCoin:
-		return parseListModeCoinDataE180(buf)
+		return parseListModeCoinDataE180(r)
 	case FileType_Mich:
-		return parseMichDataE180(buf)
+		return parseMichDataE180(r)
 	}
-	return nil
+	return parsePayload(r, fileType)
 }
 
-func parseRawDataE180(buf *bytes.Buffer) *RawDataE180 {
+func parseRawDataE180(r *Reader) (*RawDataE180, error) {
 	var infos []*BDMInfo
-	for buf.Len() > 0 {
-		info := &BDMInfo{
-			BDMIndex:   buf.Next(1)[0],
-			IP:         binary.LittleEndian.Uint16(buf.Next(2)),
-			Port:       binary.LittleEndian.Uint16(buf.Next(2)),
-			GroupNum:   buf.Next(1)[0],
-			GroupIndex: buf.Next(1)[0],
-			DataLen:    binary.LittleEndian.Uint32(buf.Next(4)),
-		}
-		var content []*BDMInfoBody
-		for i := 0; i < (int(info.DataLen) / BDMInfoBodyByteLen); i++ {
-			item := &BDMInfoBody{
-				HeadAndDU:          buf.Next(1)[0],
-				BDM:                buf.Next(1)[0],
-				Time:               buf.Next(8),
-				X:                  buf.Next(1)[0],
-				Y:                  buf.Next(1)[0],
-				Energy:             buf.Next(2),
-				TemperatureInt:     int8(buf.Next(1)[0]),
-				TemperatureAndTail: buf.Next(1)[0],
-			}
-			content = append(content, item)
-		}
-		info.Content = content
+	for {
+		info, err := r.NextBDMInfoE180()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
 		infos = append(infos, info)
 	}
-	return &RawDataE180{BDMInfos: infos}
+	return &RawDataE180{BDMInfos: infos}, nil
 }
 
-func parseListModeCoinDataE180(buf *bytes.Buffer) *ListModeCoinDataE180 {
+func parseListModeCoinDataE180(r *Reader) (*ListModeCoinDataE180, error) {
 	var pairs []CoinPair
-	for buf.Len() > 0 {
-		pair := [2]*CoinInfo{
-			{
-				GlobalCrystalIndex: binary.LittleEndian.Uint32(buf.Next(4)),
-				Energy:             readFloat32(buf),
-				TimeValue:          readFloat64(buf),
-			},
-			{
-				GlobalCrystalIndex: binary.LittleEndian.Uint32(buf.Next(4)),
-				Energy:             readFloat32(buf),
-				TimeValue:          readFloat64(buf),
-			},
+	for {
+		pair, err := r.NextCoinPairE180()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
 		}
 		pairs = append(pairs, pair)
 	}
-	return &ListModeCoinDataE180{CoinPairs: pairs}
+	return &ListModeCoinDataE180{CoinPairs: pairs}, nil
 }
 
-func parseMichDataE180(buf *bytes.Buffer) []float32 {
+func parseMichDataE180(r *Reader) ([]float32, error) {
 	var res []float32
-	for buf.Len() > 0 {
-		res = append(res, readFloat32(buf))
+	for {
+		v, err := r.NextMichE180()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
 	}
-	return res
+	return res, nil
 }
 
-func parseRawData930(buf *bytes.Buffer) *RawData930 {
+func parseRawData930(r *Reader) (*RawData930, error) {
 	var res []RawDataItem930
 	for {
-		data := buf.Next(1152)
-		if len(data) == 0 {
+		item, err := r.NextRawItem930()
+		if err == io.EOF {
 			break
 		}
-		res = append(res, RawDataItem930{
-			Data: data,
-			IP:   binary.LittleEndian.Uint16(buf.Next(2)),
-		})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, item)
 	}
-	return &RawData930{List: res}
+	return &RawData930{List: res}, nil
 }
 
-func parseListModeCoinData930(buf *bytes.Buffer) *ListModeCoinData930 {
+func parseListModeCoinData930(r *Reader) (*ListModeCoinData930, error) {
 	var res []ListModeDataItem930
 	for {
-		rawIp := buf.Next(2)
-		if len(rawIp) == 0 {
+		item, err := r.NextListModeItem930()
+		if err == io.EOF {
 			break
 		}
-		ch := binary.LittleEndian.Uint16(buf.Next(2))
-		res = append(res, ListModeDataItem930{
-			IP:       binary.LittleEndian.Uint16(rawIp),
-			XTalk:    ch&(1<<15) != 0,
-			Reserved: uint8((ch >> 12) & (1<<3 - 1)),
-			Channel:  ch & (1<<12 - 1),
-			Energy:   readFloat32(buf),
-			Time:     readFloat64(buf),
-		})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, item)
 	}
-	return &ListModeCoinData930{List: res}
+	return &ListModeCoinData930{List: res}, nil
 }
 
-func parseMichData930(buf *bytes.Buffer) []uint16 {
+func parseMichData930(r *Reader) ([]uint16, error) {
 	var res []uint16
-	for buf.Len() > 0 {
-		res = append(res, binary.LittleEndian.Uint16(buf.Next(2)))
+	for {
+		v, err := r.NextMich930()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
 	}
-	return res
-}
-
-func readFloat32(buf *bytes.Buffer) float32 {
-	var res float32
-	binary.Read(buf, binary.LittleEndian, &res)
-	return res
-}
-
-func readFloat64(buf *bytes.Buffer) float64 {
-	var res float64
-	binary.Read(buf, binary.LittleEndian, &res)
-	return res
+	return res, nil
 }
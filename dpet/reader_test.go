@@ -0,0 +1,270 @@
+package dpet
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func testHeader(device string, fileType FileType) *Header {
+	return &Header{
+		MarshalMethod: MarshallMethodProto,
+		Content: &PetFileHeader{
+			PublicInfo:  &PublicInfo{FileType: fileType, DataTransferSyntax: DataTransferSyntax_Deflate},
+			ScannerInfo: &ScannerInfo{Device: device},
+		},
+	}
+}
+
+// testListModeData930 构造n条930符合信息记录，第i条记录的通道号为i
+func testListModeData930(n int) []byte {
+	var bs []byte
+	for i := 0; i < n; i++ {
+		record := make([]byte, 16)
+		binary.LittleEndian.PutUint16(record, 0x0102)
+		binary.LittleEndian.PutUint16(record[2:], uint16(i)|1<<15)
+		bs = append(bs, record...)
+	}
+	return bs
+}
+
+// testFile 按header及未压缩的数据区构造文件，不修改文件头中的md5值
+func testFile(t *testing.T, header *Header, data []byte) []byte {
+	buf := bytes.NewBuffer(nil)
+	if err := writeHead(header, buf); err != nil {
+		t.Fatal(err)
+	}
+	cw, err := newCompressor(header.Content.PublicInfo.DataTransferSyntax, flate.DefaultCompression, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cw.Write(data)
+	if err = cw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReader(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	dataset := &Dataset{
+		Header:  testHeader(File930, FileType_ListModeCoin),
+		DataBuf: bytes.NewBuffer(testListModeData930(3)),
+	}
+	if err := Write(dataset, buf); err != nil {
+		t.Fatal(err)
+	}
+	encoded := buf.Bytes()
+
+	r, err := NewReader(bytes.NewReader(encoded))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if r.Header().Content.ScannerInfo.Device != File930 {
+		t.Fatalf("unexpected header: %v", r.Header().Content)
+	}
+	for i := 0; i < 3; i++ {
+		item, err := r.NextListModeItem930()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if item.Channel != uint16(i) || !item.XTalk || item.IP != 0x0102 {
+			t.Fatalf("unexpected item: %+v", item)
+		}
+	}
+	if _, err = r.NextListModeItem930(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+	if _, err = r.NextCoinPairE180(); err != DataTypeMismatch {
+		t.Fatalf("expected DataTypeMismatch, got %v", err)
+	}
+
+	parsed, err := Parse(bytes.NewBuffer(encoded))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list := parsed.Data.(*ListModeCoinData930).List; len(list) != 3 {
+		t.Fatalf("unexpected parsed data: %+v", list)
+	}
+}
+
+func TestReaderPartialRecord(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	data := testListModeData930(2)
+	dataset := &Dataset{
+		Header:  testHeader(File930, FileType_ListModeCoin),
+		DataBuf: bytes.NewBuffer(data[:len(data)-1]),
+	}
+	if err := Write(dataset, buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Parse(buf); err != io.ErrUnexpectedEOF {
+		t.Fatalf("expected io.ErrUnexpectedEOF, got %v", err)
+	}
+}
+
+func TestReaderTruncatedDeflate(t *testing.T) {
+	header := testHeader(FileE180, FileType_Mich)
+	data := make([]byte, 4*10000)
+	for i := range data {
+		data[i] = uint8(i * i)
+	}
+	buf := bytes.NewBuffer(nil)
+	if err := Write(&Dataset{Header: header, DataBuf: bytes.NewBuffer(data)}, buf); err != nil {
+		t.Fatal(err)
+	}
+	file := buf.Bytes()
+	head, err := marshalHead(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for cut := 1; cut < len(file)-len(head); cut += 97 {
+		if _, err = Parse(bytes.NewBuffer(file[:len(file)-cut])); err == nil {
+			t.Fatalf("truncated by %d bytes: expected error", cut)
+		}
+	}
+
+	// 早期版本Write写出的文件：数据区以Flush结束，没有结束块
+	old := proto.Clone(header.Content).(*PetFileHeader)
+	old.PublicInfo.MD5 = md5Hex(data)
+	buf = bytes.NewBuffer(nil)
+	if err = writeHead(&Header{MarshalMethod: MarshallMethodProto, Content: old}, buf); err != nil {
+		t.Fatal(err)
+	}
+	headLen := buf.Len()
+	fw, err := flate.NewWriter(buf, flate.DefaultCompression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fw.Write(data)
+	fw.Flush()
+	flushed := buf.Bytes()
+	parsed, err := Parse(bytes.NewBuffer(flushed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values := parsed.Data.([]float32); len(values) != len(data)/4 {
+		t.Fatalf("unexpected values: %d", len(values))
+	}
+	path := filepath.Join(t.TempDir(), "flushed.dpet")
+	if err = os.WriteFile(path, flushed, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = Verify(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = Parse(bytes.NewBuffer(flushed), StrictDeflate()); err != io.ErrUnexpectedEOF {
+		t.Fatalf("expected io.ErrUnexpectedEOF, got %v", err)
+	}
+	for cut := 1; cut < len(flushed)-headLen; cut += 97 {
+		if _, err = Parse(bytes.NewBuffer(flushed[:len(flushed)-cut])); err == nil {
+			t.Fatalf("truncated by %d bytes: expected error", cut)
+		}
+	}
+}
+
+func TestReaderCorruptHeadLength(t *testing.T) {
+	file := append([]byte(nil), MagicNumber...)
+	file = binary.LittleEndian.AppendUint16(file, MarshallMethodProto)
+	file = binary.LittleEndian.AppendUint32(file, math.MaxUint32)
+	file = append(file, 1, 2, 3)
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	if _, err := NewReader(bytes.NewReader(file)); err != WrongFileTypeError {
+		t.Fatalf("expected WrongFileTypeError, got %v", err)
+	}
+	runtime.ReadMemStats(&after)
+	// 不应按文件头中的长度申请内存
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 1<<20 {
+		t.Fatalf("allocated %d bytes", allocated)
+	}
+}
+
+func TestVerifyMD5(t *testing.T) {
+	data := testListModeData930(3)
+	dataset := &Dataset{
+		Header:  testHeader(File930, FileType_ListModeCoin),
+		DataBuf: bytes.NewBuffer(data),
+	}
+	path := filepath.Join(t.TempDir(), "test.dpet")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = Write(dataset, f); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	if dataset.Header.Content.PublicInfo.MD5 != "" {
+		t.Fatal("Write should not modify dataset header")
+	}
+	if err = Verify(path); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := ParseFile(path, VerifyMD5())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Header.Content.PublicInfo.MD5 != md5Hex(data) {
+		t.Fatalf("unexpected md5: %s", parsed.Header.Content.PublicInfo.MD5)
+	}
+
+	// 修改文件头中的md5值后校验应失败
+	header := parsed.Header
+	header.Content.PublicInfo.MD5 = md5Hex(nil)
+	r, err := NewReader(bytes.NewReader(testFile(t, header, data)), VerifyMD5())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = io.Copy(io.Discard, r.Data()); err != MD5Mismatch {
+		t.Fatalf("expected MD5Mismatch, got %v", err)
+	}
+
+	header.Content.PublicInfo.MD5 = ""
+	_, err = Parse(bytes.NewBuffer(testFile(t, header, data)), VerifyMD5())
+	if err != MD5Missing {
+		t.Fatalf("expected MD5Missing, got %v", err)
+	}
+}
+
+func TestTransferSyntax(t *testing.T) {
+	data := testListModeData930(100)
+	dataset := &Dataset{
+		Header:  testHeader(File930, FileType_ListModeCoin),
+		DataBuf: bytes.NewBuffer(data),
+	}
+	for syntax := range DataTransferSyntax_name {
+		syntax := DataTransferSyntax(syntax)
+		buf := bytes.NewBuffer(nil)
+		err := Write(dataset, buf, WithTransferSyntax(syntax), WithCompressionLevel(flate.BestSpeed))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if syntax == DataTransferSyntax_Uncompressed && !bytes.HasSuffix(buf.Bytes(), data) {
+			t.Fatal("uncompressed data area should be stored verbatim")
+		}
+		parsed, err := Parse(buf, NotParseData(), VerifyMD5())
+		if err != nil {
+			t.Fatalf("%v: %v", syntax, err)
+		}
+		if parsed.Header.Content.PublicInfo.DataTransferSyntax != syntax || !bytes.Equal(parsed.DataBuf.Bytes(), data) {
+			t.Fatalf("%v: round trip mismatch", syntax)
+		}
+	}
+
+	err := Write(dataset, io.Discard, WithTransferSyntax(DataTransferSyntax(100)))
+	if err != UnknownTransferSyntax {
+		t.Fatalf("expected UnknownTransferSyntax, got %v", err)
+	}
+	if err = Write(dataset, io.Discard, WithCompressionLevel(100)); err == nil {
+		t.Fatal("expected invalid compression level error")
+	}
+}
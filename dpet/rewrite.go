@@ -0,0 +1,83 @@
+package dpet
+
+import (
+	"bufio"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// RewriteHeader 修改path处文件的文件头，压缩后的数据区原样复制而不重新解压及压缩。
+// 新文件先写入同一目录下的临时文件，完成后通过重命名原子地替换原文件，出错时原文件不变。
+// edit中不能修改数据区相关的字段（传输语义、md5值及块索引），否则返回DataAreaHeaderChanged
+func RewriteHeader(path string, edit func(header *Header) error) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+
+	dst, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	// 重命名成功后临时文件已不存在，Remove不会影响目标文件
+	defer os.Remove(dst.Name())
+	err = rewriteHeader(src, dst, edit)
+	if err == nil {
+		err = dst.Chmod(info.Mode())
+	}
+	if err == nil {
+		err = dst.Sync()
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(dst.Name(), path)
+}
+
+// rewriteHeader 读取reader中的文件头并经edit修改后写入writer，之后原样复制压缩后的数据区
+func rewriteHeader(reader io.Reader, writer io.Writer, edit func(header *Header) error) error {
+	br := bufio.NewReader(reader)
+	header, err := readHead(br)
+	if err != nil {
+		return err
+	}
+	origin := proto.Clone(header.Content).(*PetFileHeader)
+	err = edit(header)
+	if err != nil {
+		return err
+	}
+	if !sameDataArea(origin, header.Content) {
+		return DataAreaHeaderChanged
+	}
+	err = writeHead(header, writer)
+	if err != nil {
+		return err
+	}
+	_, err = br.WriteTo(writer)
+	return err
+}
+
+// sameDataArea 判断两个文件头中描述数据区的字段是否一致
+func sameDataArea(a, b *PetFileHeader) bool {
+	if a.GetPublicInfo().GetDataTransferSyntax() != b.GetPublicInfo().GetDataTransferSyntax() ||
+		a.GetPublicInfo().GetMD5() != b.GetPublicInfo().GetMD5() ||
+		len(a.GetDataBlocks()) != len(b.GetDataBlocks()) {
+		return false
+	}
+	for i := range a.GetDataBlocks() {
+		if !proto.Equal(a.DataBlocks[i], b.DataBlocks[i]) {
+			return false
+		}
+	}
+	return true
+}
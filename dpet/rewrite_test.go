@@ -0,0 +1,58 @@
+package dpet
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRewriteHeader(t *testing.T) {
+	dataset := &Dataset{Header: testHeader(File930, FileType_Mich), Data: []uint16{1, 2, 3}}
+	buf := bytes.NewBuffer(nil)
+	if err := Write(dataset, buf); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "test.dpet")
+	if err := os.WriteFile(path, buf.Bytes(), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	err := RewriteHeader(path, func(header *Header) error {
+		header.Content.AcquisitionInfo = &AcquisitionInfo{PatientName: "Patient^Test"}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := ParseFile(path, VerifyMD5())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Header.Content.AcquisitionInfo.PatientName != "Patient^Test" {
+		t.Fatalf("unexpected header: %v", parsed.Header.Content)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0640 {
+		t.Fatalf("unexpected file mode: %v %v", info, err)
+	}
+
+	// 修改失败时原文件不变，且不遗留临时文件
+	before, _ := os.ReadFile(path)
+	editErr := errors.New("edit failed")
+	if err = RewriteHeader(path, func(*Header) error { return editErr }); err != editErr {
+		t.Fatalf("expected edit error, got %v", err)
+	}
+	err = RewriteHeader(path, func(header *Header) error {
+		header.Content.PublicInfo.MD5 = ""
+		return nil
+	})
+	if err != DataAreaHeaderChanged {
+		t.Fatalf("expected DataAreaHeaderChanged, got %v", err)
+	}
+	after, _ := os.ReadFile(path)
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if !bytes.Equal(before, after) || len(entries) != 1 {
+		t.Fatalf("file changed or temporary file left: %d entries", len(entries))
+	}
+}
@@ -0,0 +1,51 @@
+package dpet
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+)
+
+// newCompressor 按传输语义返回压缩写出器，Close结束压缩流但不关闭writer。
+// DeflateBlocks的块索引位于文件头中，需在写出文件头前完成压缩，不能以流的形式写出
+func newCompressor(syntax DataTransferSyntax, level int, writer io.Writer) (io.WriteCloser, error) {
+	switch syntax {
+	case DataTransferSyntax_Deflate:
+		return flate.NewWriter(writer, level)
+	case DataTransferSyntax_Uncompressed:
+		return nopWriteCloser{writer}, nil
+	case DataTransferSyntax_Zlib:
+		return zlib.NewWriterLevel(writer, level)
+	case DataTransferSyntax_Gzip:
+		return gzip.NewWriterLevel(writer, level)
+	case DataTransferSyntax_DeflateBlocks:
+		return nil, BlockSyntaxNotStreamable
+	}
+	return nil, UnknownTransferSyntax
+}
+
+// newDecompressor 按传输语义返回解压读取器，zlib及gzip在数据区结束时校验其自带的校验和
+func newDecompressor(content *PetFileHeader, reader io.Reader) (io.ReadCloser, error) {
+	switch content.GetPublicInfo().GetDataTransferSyntax() {
+	case DataTransferSyntax_Deflate:
+		return flate.NewReader(reader), nil
+	case DataTransferSyntax_Uncompressed:
+		return io.NopCloser(reader), nil
+	case DataTransferSyntax_Zlib:
+		return zlib.NewReader(reader)
+	case DataTransferSyntax_Gzip:
+		return gzip.NewReader(reader)
+	case DataTransferSyntax_DeflateBlocks:
+		return &blockStream{reader: reader, blocks: content.DataBlocks}, nil
+	}
+	return nil, UnknownTransferSyntax
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
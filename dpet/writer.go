@@ -2,109 +2,254 @@ package dpet
 
 import (
 	"bytes"
-	"compress/flate"
+	"crypto/md5"
 	"encoding/binary"
+	"encoding/hex"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"io"
+	"reflect"
 )
 
-func Write(dataset *Dataset, writer io.Writer) error {
-	fileType := dataset.Header.Content.PublicInfo.FileType
-	drive := dataset.Header.Content.ScannerInfo.Device
-	err := writeHead(dataset.Header, writer)
-	if err != nil {
+// Write 写出dataset，数据区的md5值会写入文件头PublicInfo.MD5，dataset本身不会被修改。
+// 数据区按文件头中的DataTransferSyntax压缩，可通过WithTransferSyntax另行指定
+func Write(dataset *Dataset, writer io.Writer, opt ...WriteOption) error {
+	option := genWriteOption(opt...)
+	if !option.verify {
+		_, _, err := write(dataset, writer, option)
 		return err
 	}
-	if dataset.DataBuf != nil {
-		return writeBinaryData(dataset.DataBuf, writer)
-	}
 
-	switch drive {
-	case FileI30:
-		fallthrough
-	case File930:
-		return writeData930(fileType, dataset, writer)
-	case FileE180:
-		return writeDataE180(fileType, dataset, writer)
+	// 先写入内存并重新解析校验，校验通过后再写出
+	buf := bytes.NewBuffer(nil)
+	header, data, err := write(dataset, buf, option)
+	if err != nil {
+		return err
 	}
-	return UnknownDrive
+	err = verifyWritten(buf.Bytes(), header, data, dataset.Data)
+	if err != nil {
+		return err
+	}
+	_, err = buf.WriteTo(writer)
+	return err
 }
 
-func writeData930(fileType FileType, data interface{}, writer io.Writer) error {
-	fw, err := flate.NewWriter(writer, flate.DefaultCompression)
-	defer fw.Flush()
+// verifyWritten 重新解析写出的文件，检查文件头、解压后的数据区及解析得到的数据与写出时一致
+func verifyWritten(file []byte, header *Header, data []byte, expected interface{}) error {
+	parsed, err := Parse(bytes.NewBuffer(file), NotParseData(), VerifyMD5())
 	if err != nil {
 		return err
 	}
-	switch fileType {
-	case FileType_RawData:
-		rawData, _ := data.(*RawData930)
-		return writeRawData930(rawData, fw)
-	case FileType_ListModeCoin:
-		listMode, _ := data.(*ListModeCoinData930)
-		return writeListModeCoinData930(listMode, fw)
-	case FileType_Mich:
-		mich, _ := data.([]uint16)
-		return writeMichData930(mich, fw)
+	if parsed.Header.MarshalMethod != header.MarshalMethod || !proto.Equal(parsed.Header.Content, header.Content) ||
+		!bytes.Equal(parsed.DataBuf.Bytes(), data) {
+		return WriteVerifyMismatch
 	}
-	return UnknownFileType
-}
-
-func writeDataE180(fileType FileType, data interface{}, writer io.Writer) error {
-	fw, err := flate.NewWriter(writer, flate.DefaultCompression)
-	defer fw.Flush()
+	if expected == nil {
+		return nil
+	}
+	parsed, err = Parse(bytes.NewBuffer(file))
 	if err != nil {
 		return err
 	}
-	switch fileType {
-	case FileType_RawData:
-		rawData, _ := data.(*RawDataE180)
-		return writeRawDataE180(rawData, fw)
-	case FileType_ListModeCoin:
-		listMode, _ := data.(*ListModeCoinDataE180)
-		return writeListModeCoinDataE180(listMode, fw)
-	case FileType_Mich:
-		mich, _ := data.([]float32)
-		return writeMichDataE180(mich, fw)
+	if !reflect.DeepEqual(parsed.Data, expected) {
+		return WriteVerifyMismatch
 	}
-	return UnknownFileType
+	return nil
 }
 
-func writeHead(header *Header, writer io.Writer) error {
-	_, err := writer.Write(MagicNumber)
+// write 写出dataset，返回实际写出的文件头及未压缩的数据区
+func write(dataset *Dataset, writer io.Writer, option *WriteOptionSet) (*Header, []byte, error) {
+	data := bytes.NewBuffer(nil)
+	if dataset.DataBuf != nil {
+		data = bytes.NewBuffer(dataset.DataBuf.Bytes())
+	} else {
+		err := writeData(dataset.Header.Content, dataset.Data, data)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	header := &Header{
+		MarshalMethod: dataset.Header.MarshalMethod,
+		Content:       proto.Clone(dataset.Header.Content).(*PetFileHeader),
+	}
+	header.Content.PublicInfo.MD5 = md5Hex(data.Bytes())
+	option.apply(header.Content.PublicInfo)
+	if header.Content.PublicInfo.DataTransferSyntax == DataTransferSyntax_DeflateBlocks {
+		return header, data.Bytes(), writeBlocks(header, data.Bytes(), option, writer)
+	}
+	header.Content.DataBlocks = nil
+	// 先创建压缩器，传输语义或压缩级别无效时不写出任何内容
+	cw, err := newCompressor(header.Content.PublicInfo.DataTransferSyntax, option.level, writer)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	err = binary.Write(writer, binary.LittleEndian, uint16(MarshallMethodProto))
+	err = writeHead(header, writer)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	content, err := proto.Marshal(header.Content)
+	_, err = cw.Write(data.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+	return header, data.Bytes(), cw.Close()
+}
+
+// writeBlocks 将数据区按记录分块并行压缩，写出带有块索引的文件头及各块
+func writeBlocks(header *Header, data []byte, option *WriteOptionSet, writer io.Writer) error {
+	spans, err := splitBlocks(header.Content, data, option.blockRecords)
 	if err != nil {
 		return err
 	}
-	err = binary.Write(writer, binary.LittleEndian, uint32(len(content)))
+	compressed, err := compressBlocks(data, spans, option.level)
 	if err != nil {
 		return err
 	}
-	_, err = writer.Write(content)
+	header.Content.DataBlocks = make([]*DataBlock, len(spans))
+	for i := range spans {
+		header.Content.DataBlocks[i] = spans[i].block
+	}
+	err = writeHead(header, writer)
 	if err != nil {
 		return err
 	}
+	for _, block := range compressed {
+		_, err = writer.Write(block)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func writeBinaryData(buf *bytes.Buffer, writer io.Writer) error {
-	fw, err := flate.NewWriter(writer, flate.DefaultCompression)
+// writeData 使用设备对应的编解码器将未压缩的数据区写入writer
+func writeData(content *PetFileHeader, data interface{}, writer io.Writer) error {
+	codec, ok := LookupCodec(content.ScannerInfo.Device)
+	if !ok {
+		return UnknownDrive
+	}
+	return codec.Encode(content, data, writer)
+}
+
+func writeData930(content *PetFileHeader, data interface{}, writer io.Writer) error {
+	switch fileType := content.PublicInfo.FileType; fileType {
+	case FileType_RawData:
+		rawData, ok := data.(*RawData930)
+		if !ok || rawData == nil {
+			return DataTypeMismatch
+		}
+		return writeRawData930(rawData, writer)
+	case FileType_ListModeCoin:
+		listMode, ok := data.(*ListModeCoinData930)
+		if !ok || listMode == nil {
+			return DataTypeMismatch
+		}
+		return writeListModeCoinData930(listMode, writer)
+	case FileType_Mich:
+		mich, ok := data.([]uint16)
+		if !ok {
+			return DataTypeMismatch
+		}
+		return writeMichData930(mich, writer)
+	default:
+		return writePayload(content, fileType, data, writer)
+	}
+}
+
+func writeDataE180(content *PetFileHeader, data interface{}, writer io.Writer) error {
+	switch fileType := content.PublicInfo.FileType; fileType {
+	case FileType_RawData:
+		rawData, ok := data.(*RawDataE180)
+		if !ok || rawData == nil {
+			return DataTypeMismatch
+		}
+		return writeRawDataE180(rawData, writer)
+	case FileType_ListModeCoin:
+		listMode, ok := data.(*ListModeCoinDataE180)
+		if !ok || listMode == nil {
+			return DataTypeMismatch
+		}
+		return writeListModeCoinDataE180(listMode, writer)
+	case FileType_Mich:
+		mich, ok := data.([]float32)
+		if !ok {
+			return DataTypeMismatch
+		}
+		return writeMichDataE180(mich, writer)
+	default:
+		return writePayload(content, fileType, data, writer)
+	}
+}
+
+func writeHead(header *Header, writer io.Writer) error {
+	head, err := marshalHead(header)
 	if err != nil {
 		return err
 	}
-	//_, err = io.Copy(fw, buf)
-	_, err = io.CopyBuffer(fw, buf, make([]byte, 128*1024*1024))
+	_, err = writer.Write(head)
+	return err
+}
+
+// jsonMarshalOptions 以多行缩进格式输出全部字段，便于手工编辑
+var jsonMarshalOptions = protojson.MarshalOptions{
+	Multiline:       true,
+	Indent:          "  ",
+	EmitUnpopulated: true,
+}
+
+// jsonHeadAlign json文件头补齐到的字节数
+const jsonHeadAlign = 1024
+
+// padJSONHead 在json文件头末尾补充空格并以换行结束，使长度为jsonHeadAlign的整数倍。
+// 文件头长度以二进制形式存放在json之前，用文本编辑器修改时可增减末尾的空格以保持总长度不变
+func padJSONHead(content []byte) []byte {
+	l := (len(content)/jsonHeadAlign + 1) * jsonHeadAlign
+	padded := bytes.Repeat([]byte{' '}, l)
+	copy(padded, content)
+	padded[l-1] = '\n'
+	return padded
+}
+
+// ConvertMarshalMethod 将reader中文件的文件头改为以method序列化后写入writer，压缩后的数据区原样复制
+func ConvertMarshalMethod(reader io.Reader, writer io.Writer, method uint16) error {
+	return rewriteHeader(reader, writer, func(header *Header) error {
+		header.MarshalMethod = method
+		return nil
+	})
+}
+
+// marshalHead 序列化文件头，包括魔数、序列化方式、文件头长度及文件头内容
+func marshalHead(header *Header) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	buf.Write(MagicNumber)
+	err := binary.Write(buf, binary.LittleEndian, header.MarshalMethod)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	var content []byte
+	switch header.MarshalMethod {
+	case MarshallMethodProto:
+		content, err = proto.Marshal(header.Content)
+	case MarshallMethodJSON:
+		content, err = jsonMarshalOptions.Marshal(header.Content)
+		content = padJSONHead(content)
+	default:
+		return nil, UnknownMarshalMethod
+	}
+	if err != nil {
+		return nil, err
 	}
-	return fw.Flush()
+	err = binary.Write(buf, binary.LittleEndian, uint32(len(content)))
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(content)
+	return buf.Bytes(), nil
+}
+
+func md5Hex(data []byte) string {
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:])
 }
 
 func writeRawDataE180(data *RawDataE180, w io.Writer) (err error) {
@@ -177,9 +322,9 @@ func writeRawData930(data *RawData930, w io.Writer) (err error) {
 func writeListModeCoinData930(data *ListModeCoinData930, w io.Writer) (err error) {
 	for _, item := range data.List {
 		err = binary.Write(w, binary.LittleEndian, item.IP)
-		ch := uint16(item.Reserved)<<12 + item.Channel
+		ch := uint16(item.Reserved&(1<<3-1))<<12 | item.Channel&(1<<12-1)
 		if item.XTalk {
-			ch &= 1 << 15
+			ch |= 1 << 15
 		}
 		err = binary.Write(w, binary.LittleEndian, ch)
 		err = binary.Write(w, binary.LittleEndian, item.Energy)
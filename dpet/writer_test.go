@@ -0,0 +1,122 @@
+package dpet
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteRoundTrip(t *testing.T) {
+	raw930 := make([]uint8, 1152)
+	for i := range raw930 {
+		raw930[i] = uint8(i)
+	}
+	body := &BDMInfoBody{HeadAndDU: 1, BDM: 2, Time: []uint8{1, 2, 3, 4, 5, 6, 7, 8}, X: 3, Y: 4, Energy: []uint8{5, 6}, TemperatureInt: -7, TemperatureAndTail: 8}
+	cases := []*Dataset{
+		{Header: testHeader(File930, FileType_RawData), Data: &RawData930{List: []RawDataItem930{{Data: raw930, IP: 0x0102}}}},
+		{Header: testHeader(File930, FileType_ListModeCoin), Data: &ListModeCoinData930{List: []ListModeDataItem930{
+			{IP: 0x0102, XTalk: true, Reserved: 5, Channel: 4095, Energy: 511, Time: 1.5},
+			{IP: 0x0103, Channel: 1, Energy: 480, Time: 2.5},
+		}}},
+		{Header: testHeader(File930, FileType_Mich), Data: []uint16{1, 2, 3}},
+		{Header: testHeader(FileE180, FileType_RawData), Data: &RawDataE180{BDMInfos: []*BDMInfo{
+			{BDMIndex: 1, IP: 2, Port: 3, GroupNum: 4, GroupIndex: 5, DataLen: 2 * BDMInfoBodyByteLen, Content: []*BDMInfoBody{body, body}},
+		}}},
+		{Header: testHeader(FileE180, FileType_ListModeCoin), Data: &ListModeCoinDataE180{CoinPairs: []CoinPair{
+			{{GlobalCrystalIndex: 1, Energy: 511, TimeValue: 1}, {GlobalCrystalIndex: 2, Energy: 500, TimeValue: 1.5}},
+		}}},
+		{Header: testHeader(FileE180, FileType_Mich), Data: []float32{1, 2, 3}},
+	}
+	for _, dataset := range cases {
+		content := dataset.Header.Content
+		buf := bytes.NewBuffer(nil)
+		if err := Write(dataset, buf, WriteVerified()); err != nil {
+			t.Fatalf("%s %v: %v", content.ScannerInfo.Device, content.PublicInfo.FileType, err)
+		}
+		written := append([]byte(nil), buf.Bytes()...)
+		parsed, err := Parse(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rewritten := bytes.NewBuffer(nil)
+		if err = Write(parsed, rewritten); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(written, rewritten.Bytes()) {
+			t.Fatalf("%s %v: rewritten file differs", content.ScannerInfo.Device, content.PublicInfo.FileType)
+		}
+	}
+
+	// 超出位宽的字段无法无损写出
+	dataset := &Dataset{
+		Header: testHeader(File930, FileType_ListModeCoin),
+		Data:   &ListModeCoinData930{List: []ListModeDataItem930{{Reserved: 8}}},
+	}
+	buf := bytes.NewBuffer(nil)
+	if err := Write(dataset, buf, WriteVerified()); err != WriteVerifyMismatch || buf.Len() != 0 {
+		t.Fatalf("expected WriteVerifyMismatch, got %v", err)
+	}
+}
+
+func TestWriteDataTypeMismatch(t *testing.T) {
+	for _, device := range []string{File930, FileE180} {
+		for _, fileType := range []FileType{FileType_RawData, FileType_ListModeCoin, FileType_Mich} {
+			for _, data := range []interface{}{nil, "data", (*RawData930)(nil), (*ListModeCoinDataE180)(nil)} {
+				dataset := &Dataset{Header: testHeader(device, fileType), Data: data}
+				if err := Write(dataset, bytes.NewBuffer(nil)); err != DataTypeMismatch {
+					t.Fatalf("%s %v %T: expected DataTypeMismatch, got %v", device, fileType, data, err)
+				}
+			}
+		}
+	}
+	dataset := &Dataset{Header: testHeader(File930, FileType_RawData), Data: []uint16{1}}
+	if err := Write(dataset, bytes.NewBuffer(nil)); err != DataTypeMismatch {
+		t.Fatalf("expected DataTypeMismatch, got %v", err)
+	}
+}
+
+func TestMarshalMethodJSON(t *testing.T) {
+	header := testHeader(File930, FileType_Mich)
+	header.MarshalMethod = MarshallMethodJSON
+	header.Content.ScannerInfo.Serial = "SN-001"
+	dataset := &Dataset{Header: header, Data: []uint16{1, 2, 3}}
+	buf := bytes.NewBuffer(nil)
+	if err := Write(dataset, buf, WriteVerified()); err != nil {
+		t.Fatal(err)
+	}
+	jsonFile := append([]byte(nil), buf.Bytes()...)
+	if !bytes.Contains(jsonFile, []byte(`"serial": "SN-001"`)) && !bytes.Contains(jsonFile, []byte(`"serial":  "SN-001"`)) {
+		t.Fatalf("header is not stored as json:\n%s", jsonFile)
+	}
+
+	protoFile := bytes.NewBuffer(nil)
+	if err := ConvertMarshalMethod(bytes.NewReader(jsonFile), protoFile, MarshallMethodProto); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := Parse(bytes.NewBuffer(protoFile.Bytes()), VerifyMD5())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Header.MarshalMethod != MarshallMethodProto || parsed.Header.Content.ScannerInfo.Serial != "SN-001" {
+		t.Fatalf("unexpected header: %+v", parsed.Header)
+	}
+
+	// 转换不改变压缩后的数据区
+	converted := bytes.NewBuffer(nil)
+	if err = ConvertMarshalMethod(bytes.NewReader(protoFile.Bytes()), converted, MarshallMethodJSON); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(converted.Bytes(), jsonFile) {
+		t.Fatal("converted file differs")
+	}
+	// 模拟文本编辑：修改字段并删除一个末尾空格以保持文件头长度
+	edited := bytes.Replace(jsonFile, []byte("SN-001"), []byte("SN-0012"), 1)
+	edited = bytes.Replace(edited, []byte(" \n"), []byte("\n"), 1)
+	parsed, err = Parse(bytes.NewBuffer(edited), VerifyMD5())
+	if err != nil || parsed.Header.Content.ScannerInfo.Serial != "SN-0012" {
+		t.Fatalf("unexpected edited header: %v %v", err, parsed)
+	}
+
+	if err = ConvertMarshalMethod(bytes.NewReader(jsonFile), bytes.NewBuffer(nil), 100); err != UnknownMarshalMethod {
+		t.Fatalf("expected UnknownMarshalMethod, got %v", err)
+	}
+}
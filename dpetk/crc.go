@@ -0,0 +1,69 @@
+package dpetk
+
+// crc16Table CRC-16/CCITT-FALSE查找表，多项式0x1021
+var crc16Table = func() [256]uint16 {
+	var table [256]uint16
+	for i := range table {
+		crc := uint16(i) << 8
+		for j := 0; j < 8; j++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+		table[i] = crc
+	}
+	return table
+}()
+
+const crc16Init uint16 = 0xffff
+
+// CRC16 计算bs的CRC-16/CCITT-FALSE校验值。
+// 该算法及HeaderCRC覆盖的字节范围（headerCRCSkip至文件头末尾）尚未与厂商格式说明或设备实际输出的文件核对，
+// 因此VerifyChecksum报告的不一致及UpdateChecksum写出的校验值不一定与厂商软件一致
+func CRC16(bs []byte) uint16 {
+	return crc16Update(crc16Init, bs)
+}
+
+func crc16Update(crc uint16, bs []byte) uint16 {
+	for _, b := range bs {
+		crc = crc<<8 ^ crc16Table[byte(crc>>8)^b]
+	}
+	return crc
+}
+
+// headerCRCSkip 文件头中不参与HeaderCRC计算的前缀长度：16字节魔数及HeaderCRC字段本身
+const headerCRCSkip = 16 + 2
+
+// checksum 在读取过程中累计文件头及数据区的CRC16和数据区长度
+type checksum struct {
+	skip    int
+	header  uint16
+	data    uint16
+	dataLen int64
+	inData  bool
+}
+
+func newChecksum() *checksum {
+	return &checksum{skip: headerCRCSkip, header: crc16Init, data: crc16Init}
+}
+
+func (c *checksum) Write(bs []byte) (int, error) {
+	n := len(bs)
+	if c.inData {
+		c.data = crc16Update(c.data, bs)
+		c.dataLen += int64(n)
+		return n, nil
+	}
+	if c.skip > 0 {
+		skip := c.skip
+		if skip > len(bs) {
+			skip = len(bs)
+		}
+		c.skip -= skip
+		bs = bs[skip:]
+	}
+	c.header = crc16Update(c.header, bs)
+	return n, nil
+}
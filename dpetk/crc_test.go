@@ -0,0 +1,120 @@
+package dpetk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestCRC16(t *testing.T) {
+	// CRC-16/CCITT-FALSE标准校验值
+	if crc := CRC16([]byte("123456789")); crc != 0x29b1 {
+		t.Fatalf("unexpected crc: %#04x", crc)
+	}
+}
+
+// withChecksum 按文件头长度headerLen回填DataInfo.DataLength、DataInfo.CRC及HeaderCRC
+func withChecksum(file []byte, headerLen int) []byte {
+	file = append([]byte(nil), file...)
+	data := file[headerLen:]
+	binary.LittleEndian.PutUint32(file[headerLen-6:], uint32(len(data)))
+	binary.LittleEndian.PutUint16(file[headerLen-2:], CRC16(data))
+	binary.LittleEndian.PutUint16(file[16:], CRC16(file[headerCRCSkip:headerLen]))
+	return file
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	dataSet := testDataSet(MichDataType)
+	dataSet.MichData = []uint16{1, 2, 3, 4}
+	buf := bytes.NewBuffer(nil)
+	if err := Write(dataSet, buf); err != nil {
+		t.Fatal(err)
+	}
+	file := withChecksum(buf.Bytes(), rawHeaderLen)
+
+	if _, err := Parse(bytes.NewReader(file), VerifyChecksum()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Parse(bytes.NewReader(file), NotParseData(), VerifyChecksum()); err != nil {
+		t.Fatal(err)
+	}
+
+	damaged := append([]byte(nil), file...)
+	damaged[len(damaged)-1] ^= 0xff
+	var dataErr *DataCRCError
+	if _, err := Parse(bytes.NewReader(damaged), VerifyChecksum()); !errors.As(err, &dataErr) {
+		t.Fatalf("expected DataCRCError, got %v", err)
+	}
+	// 未开启校验时不检查
+	if _, err := Parse(bytes.NewReader(damaged)); err != nil {
+		t.Fatal(err)
+	}
+
+	damaged = append([]byte(nil), file...)
+	damaged[100] ^= 0xff
+	var headerErr *HeaderCRCError
+	if _, err := Parse(bytes.NewReader(damaged), VerifyChecksum()); !errors.As(err, &headerErr) {
+		t.Fatalf("expected HeaderCRCError, got %v", err)
+	}
+
+	var lengthErr *DataLengthError
+	if _, err := Parse(bytes.NewReader(file[:len(file)-2]), VerifyChecksum()); !errors.As(err, &lengthErr) {
+		t.Fatalf("expected DataLengthError, got %v", err)
+	}
+}
+
+func TestReaderVerifyChecksum(t *testing.T) {
+	file := withChecksum(testFile(ListmodeDataType, testListmodeData(3)), rawHeaderLen)
+	file[len(file)-1] ^= 0xff
+	r, err := NewReader(bytes.NewReader(file), VerifyChecksum())
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := make([]ListmodeDataItem, 10)
+	if n, err := r.ReadListmode(items); n != 3 || err != nil {
+		t.Fatalf("unexpected batch: %d %v", n, err)
+	}
+	var dataErr *DataCRCError
+	if _, err = r.ReadListmode(items); !errors.As(err, &dataErr) {
+		t.Fatalf("expected DataCRCError, got %v", err)
+	}
+	if _, err = r.NextListmode(); err == io.EOF {
+		t.Fatal("checksum error must not be replaced by io.EOF")
+	}
+}
+
+func TestWriteUpdateChecksum(t *testing.T) {
+	dataSet := testDataSet(MichDataType)
+	dataSet.MichData = []uint16{1, 2, 3, 4}
+	plain := bytes.NewBuffer(nil)
+	if err := Write(dataSet, plain); err != nil {
+		t.Fatal(err)
+	}
+	buf := bytes.NewBuffer(nil)
+	if err := Write(dataSet, buf, UpdateChecksum()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), withChecksum(plain.Bytes(), rawHeaderLen)) {
+		t.Fatal("unexpected checksum fields")
+	}
+	if dataSet.DataInfo.DataLength != 0 || dataSet.DataInfo.CRC != 0x5678 || dataSet.PublicInfo.HeaderCRC != 0x1234 {
+		t.Fatal("Write should not modify dataset")
+	}
+	parsed, err := Parse(buf, VerifyChecksum())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 修改后重新写出的文件仍能通过校验
+	parsed.MichData[0] = 100
+	parsed.AcquisitionInfo.PatientName = "Li Si"
+	buf.Reset()
+	if err = Write(parsed, buf, UpdateChecksum()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = Parse(buf, VerifyChecksum()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,102 @@
+package dpetk
+
+import (
+	"fmt"
+	"net"
+)
+
+// DetectorAddr 探测器地址，取值为探测器IP地址的后两段，高8位为第三段，低8位为第四段，
+// 与DeviceInfo.IpStart的取值方式一致
+type DetectorAddr uint16
+
+// Subnet 探测器网络IP地址的前两段
+type Subnet [2]byte
+
+// DefaultSubnet 返回未指定网段时使用的192.168网段
+func DefaultSubnet() Subnet {
+	return Subnet{192, 168}
+}
+
+// String 返回探测器在DefaultSubnet网段中的IP地址字符串，其它网段使用IPIn或DetectorRange.IP
+func (a DetectorAddr) String() string {
+	return a.IPIn(DefaultSubnet()).String()
+}
+
+// IP 返回探测器在DefaultSubnet网段中的IP地址
+func (a DetectorAddr) IP() net.IP {
+	return a.IPIn(DefaultSubnet())
+}
+
+// IPIn 返回探测器在指定网段中的IP地址
+func (a DetectorAddr) IPIn(subnet Subnet) net.IP {
+	return net.IPv4(subnet[0], subnet[1], byte(a>>8), byte(a))
+}
+
+// DetectorAddrFromIP 由IPv4地址的后两段得到探测器地址，不检查网段
+func DetectorAddrFromIP(ip net.IP) (DetectorAddr, error) {
+	ip4 := ip.To4()
+	if ip4 == nil {
+		return 0, fmt.Errorf("detector ip %v is not an ipv4 address", ip)
+	}
+	return DetectorAddr(ip4[2])<<8 | DetectorAddr(ip4[3]), nil
+}
+
+// ParseDetectorAddr 解析形如192.168.1.2的IP地址字符串
+func ParseDetectorAddr(s string) (DetectorAddr, error) {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return 0, fmt.Errorf("invalid detector ip %q", s)
+	}
+	return DetectorAddrFromIP(ip)
+}
+
+// DetectorRange 文件所覆盖的探测器地址及通道范围，提供地址、通道与逻辑探测器序号间的映射。
+// 逻辑序号按地址优先排列：(addr-IpStart)*ChannelCounts + (channel-ChannelStart)
+type DetectorRange struct {
+	IpStart       uint16
+	IpCounts      uint16
+	ChannelStart  uint16
+	ChannelCounts uint16
+	// Subnet 探测器所在网段，解析时由WithSubnet指定
+	Subnet Subnet
+}
+
+// NewDetectorRange 由DeviceInfo中的IpStart、IpCounts、ChannelStart及ChannelCounts构造探测器范围，网段为DefaultSubnet
+func NewDetectorRange(info *DeviceInfo) DetectorRange {
+	return DetectorRange{
+		IpStart:       info.IpStart,
+		IpCounts:      info.IpCounts,
+		ChannelStart:  info.ChannelStart,
+		ChannelCounts: info.ChannelCounts,
+		Subnet:        DefaultSubnet(),
+	}
+}
+
+// IP 返回探测器在范围所属网段中的IP地址
+func (r DetectorRange) IP(addr DetectorAddr) net.IP {
+	return addr.IPIn(r.Subnet)
+}
+
+// Channels 范围内的通道总数，即逻辑探测器个数
+func (r DetectorRange) Channels() int {
+	return int(r.IpCounts) * int(r.ChannelCounts)
+}
+
+// Index 返回探测器地址和通道对应的逻辑探测器序号
+func (r DetectorRange) Index(addr DetectorAddr, channel uint16) (int, error) {
+	ip := uint16(addr)
+	if ip < r.IpStart || ip-r.IpStart >= r.IpCounts ||
+		channel < r.ChannelStart || channel-r.ChannelStart >= r.ChannelCounts {
+		return 0, &DetectorRangeError{Addr: addr, Channel: channel, Range: r}
+	}
+	return int(ip-r.IpStart)*int(r.ChannelCounts) + int(channel-r.ChannelStart), nil
+}
+
+// Locate Index的逆过程，返回逻辑探测器序号对应的探测器地址和通道
+func (r DetectorRange) Locate(index int) (DetectorAddr, uint16, error) {
+	if index < 0 || index >= r.Channels() {
+		return 0, 0, fmt.Errorf("detector index %d out of range [0, %d)", index, r.Channels())
+	}
+	addr := DetectorAddr(r.IpStart + uint16(index/int(r.ChannelCounts)))
+	return addr, r.ChannelStart + uint16(index%int(r.ChannelCounts)), nil
+}
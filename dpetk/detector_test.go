@@ -0,0 +1,56 @@
+package dpetk
+
+import (
+	"errors"
+	"net"
+	"testing"
+)
+
+func TestDetectorAddr(t *testing.T) {
+	addr := DetectorAddr(0x0a14)
+	if addr.String() != "192.168.10.20" {
+		t.Fatalf("unexpected address %s", addr)
+	}
+	if ip := addr.IPIn(Subnet{10, 1}); !ip.Equal(net.IPv4(10, 1, 10, 20)) {
+		t.Fatalf("unexpected ip %v", ip)
+	}
+	r := NewDetectorRange(&DeviceInfo{})
+	if ip := r.IP(addr); !ip.Equal(net.IPv4(192, 168, 10, 20)) {
+		t.Fatalf("unexpected ip %v", ip)
+	}
+	r.Subnet = Subnet{10, 1}
+	if ip := r.IP(addr); !ip.Equal(net.IPv4(10, 1, 10, 20)) {
+		t.Fatalf("unexpected ip %v", ip)
+	}
+	parsed, err := ParseDetectorAddr("10.1.10.20")
+	if err != nil || parsed != addr {
+		t.Fatalf("unexpected parse result: %v %v", parsed, err)
+	}
+	if _, err = ParseDetectorAddr("not an ip"); err == nil {
+		t.Fatal("expected error for invalid ip")
+	}
+}
+
+func TestDetectorRange(t *testing.T) {
+	r := NewDetectorRange(&DeviceInfo{IpStart: 0x0101, IpCounts: 3, ChannelStart: 1, ChannelCounts: 4})
+	for i := 0; i < r.Channels(); i++ {
+		addr, channel, err := r.Locate(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		index, err := r.Index(addr, channel)
+		if err != nil || index != i {
+			t.Fatalf("index %d mapped back to %d: %v", i, index, err)
+		}
+	}
+	if index, _ := r.Index(0x0102, 4); index != 7 {
+		t.Fatalf("unexpected index %d", index)
+	}
+	var rangeErr *DetectorRangeError
+	if _, err := r.Index(0x0104, 1); !errors.As(err, &rangeErr) {
+		t.Fatalf("expected DetectorRangeError, got %v", err)
+	}
+	if _, err := r.Index(0x0101, 0); !errors.As(err, &rangeErr) {
+		t.Fatalf("expected DetectorRangeError, got %v", err)
+	}
+}
@@ -8,7 +8,17 @@ const (
 	EnergyCalibrationMap
 	TimeCalibrationMap
 	EnergySpectrumData
+	ImageDataType
 )
 
-// IP前缀
-const ipPrefix = "192.168."
+// MagicKey PublicInfo.MagicKey为空时写出的16字节魔数。该值尚未与厂商格式说明及设备实际输出的文件核对，
+// 因此解析时不检查魔数，文件中的原始魔数保存在PublicInfo.MagicKey中
+var MagicKey = [16]byte{'D', 'i', 'g', 'i', 't', 'M', 'I', ' ', 'P', 'E', 'T', ' ', 'D', 'a', 't', 'a'}
+
+// 数据区记录长度
+const (
+	rawDataPacketLen    = 1152
+	rawDataItemLen      = rawDataPacketLen + 2
+	listmodeDataItemLen = 2 + 2 + 4 + 8
+	michDataItemLen     = 2
+)
@@ -0,0 +1,120 @@
+package dpetk
+
+import "fmt"
+
+// Section 文件区段，用于定位解析错误发生的位置
+type Section string
+
+const (
+	SectionPublicInfo      Section = "PublicInfo"
+	SectionDeviceInfo      Section = "DeviceInfo"
+	SectionAcquisitionInfo Section = "AcquisitionInfo"
+	SectionImageInfo       Section = "ImageInfo"
+	SectionDataInfo        Section = "DataInfo"
+	SectionData            Section = "data area"
+)
+
+// ParseError 解析错误，记录出错的区段以及出错字段在输入中的字节偏移
+type ParseError struct {
+	Section Section
+	Offset  int64
+	Err     error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse %s at offset %d: %v", e.Section, e.Offset, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// PartialRecordError 数据区末尾存在不完整的记录
+type PartialRecordError struct {
+	// 完整记录的字节数
+	RecordSize int
+	// 末尾剩余的字节数
+	Remaining int
+}
+
+func (e *PartialRecordError) Error() string {
+	return fmt.Sprintf("partial trailing record: %d of %d bytes", e.Remaining, e.RecordSize)
+}
+
+// DataTypeError 文件类型与所请求的数据类型不一致
+type DataTypeError struct {
+	Expected uint16
+	Actual   uint16
+}
+
+func (e *DataTypeError) Error() string {
+	return fmt.Sprintf("data type mismatch: expected %d, got %d", e.Expected, e.Actual)
+}
+
+// ImageSizeError 图像数据区长度与ImageInfo中声明的尺寸不一致
+type ImageSizeError struct {
+	Rows   uint16
+	Cols   uint16
+	Slices uint16
+	// 按声明尺寸计算的数据区字节数
+	Expected int64
+	// 数据区实际字节数
+	Actual int64
+}
+
+func (e *ImageSizeError) Error() string {
+	return fmt.Sprintf("image data area is %d bytes, but %dx%dx%d float32 volume requires %d bytes",
+		e.Actual, e.Rows, e.Cols, e.Slices, e.Expected)
+}
+
+// DetectorRangeError 探测器地址或通道超出文件所覆盖的探测器范围
+type DetectorRangeError struct {
+	Addr    DetectorAddr
+	Channel uint16
+	Range   DetectorRange
+}
+
+func (e *DetectorRangeError) Error() string {
+	return fmt.Sprintf("detector %#04x channel %d out of range: ip [%#04x, +%d), channel [%d, +%d)",
+		uint16(e.Addr), e.Channel, e.Range.IpStart, e.Range.IpCounts, e.Range.ChannelStart, e.Range.ChannelCounts)
+}
+
+// ChannelDataSizeError 按通道组织的数据区长度不能均分到每个通道
+type ChannelDataSizeError struct {
+	Channels int
+	Actual   int64
+}
+
+func (e *ChannelDataSizeError) Error() string {
+	return fmt.Sprintf("data area of %d bytes cannot be split into %d channels of 4-byte values", e.Actual, e.Channels)
+}
+
+// HeaderCRCError 文件头CRC校验失败
+type HeaderCRCError struct {
+	Expected uint16
+	Actual   uint16
+}
+
+func (e *HeaderCRCError) Error() string {
+	return fmt.Sprintf("header crc mismatch: expected %#04x, got %#04x", e.Expected, e.Actual)
+}
+
+// DataCRCError 数据区CRC校验失败
+type DataCRCError struct {
+	Expected uint16
+	Actual   uint16
+}
+
+func (e *DataCRCError) Error() string {
+	return fmt.Sprintf("data crc mismatch: expected %#04x, got %#04x", e.Expected, e.Actual)
+}
+
+// DataLengthError 数据区实际长度与DataInfo.DataLength不一致
+type DataLengthError struct {
+	Expected uint32
+	Actual   int64
+}
+
+func (e *DataLengthError) Error() string {
+	return fmt.Sprintf("data length mismatch: expected %d bytes, got %d", e.Expected, e.Actual)
+}
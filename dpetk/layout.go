@@ -0,0 +1,248 @@
+package dpetk
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// FieldKind 文件头字段的二进制类型
+type FieldKind int
+
+const (
+	FieldUint16 FieldKind = iota
+	FieldUint32
+	FieldFloat32
+	FieldFloat64
+	// FieldString 定长字符串，Len为字节数
+	FieldString
+	// FieldFloat32Slice 定长float32数组，Len为元素个数
+	FieldFloat32Slice
+	// FieldUint32Slice 定长uint32数组，Len为元素个数
+	FieldUint32Slice
+	// FieldPadding 保留字节，Len为字节数，解析时跳过，写出时填零
+	FieldPadding
+)
+
+// fieldKindTypes 各字段类型对应的结构体字段类型
+var fieldKindTypes = map[FieldKind]reflect.Type{
+	FieldUint16:       reflect.TypeOf(uint16(0)),
+	FieldUint32:       reflect.TypeOf(uint32(0)),
+	FieldFloat32:      reflect.TypeOf(float32(0)),
+	FieldFloat64:      reflect.TypeOf(float64(0)),
+	FieldString:       reflect.TypeOf(""),
+	FieldFloat32Slice: reflect.TypeOf([]float32(nil)),
+	FieldUint32Slice:  reflect.TypeOf([]uint32(nil)),
+}
+
+// Field 信息区中的一个字段
+type Field struct {
+	// 对应结构体中的字段名，FieldPadding无需填写
+	Name string
+	Kind FieldKind
+	Len  int
+}
+
+// Layout 某一软件版本下各信息区的字段顺序及宽度。
+// PublicInfo中包含软件版本号，其布局固定，不随版本变化
+type Layout struct {
+	DeviceInfo      []Field
+	AcquisitionInfo []Field
+	ImageInfo       []Field
+	DataInfo        []Field
+}
+
+// DefaultLayout 未注册的软件版本使用的默认布局
+var DefaultLayout = &Layout{
+	DeviceInfo: []Field{
+		{Name: "Length", Kind: FieldUint32},
+		{Name: "Device", Kind: FieldString, Len: 16},
+		{Name: "Serial", Kind: FieldString, Len: 16},
+		{Name: "AxisDetectors", Kind: FieldUint16},
+		{Name: "TransDetectors", Kind: FieldUint16},
+		{Name: "DetectorsRings", Kind: FieldUint16},
+		{Name: "DetectorsChannels", Kind: FieldUint16},
+		{Name: "IpCounts", Kind: FieldUint16},
+		{Name: "IpStart", Kind: FieldUint16},
+		{Name: "ChannelCounts", Kind: FieldUint16},
+		{Name: "ChannelStart", Kind: FieldUint16},
+		{Name: "MvtThresholds", Kind: FieldFloat32Slice, Len: 8},
+		{Name: "MvtParameters", Kind: FieldFloat32Slice, Len: 3},
+	},
+	AcquisitionInfo: []Field{
+		{Name: "Length", Kind: FieldUint32},
+		{Name: "Isotope", Kind: FieldUint16},
+		{Name: "Activity", Kind: FieldFloat32},
+		{Name: "InjectTime", Kind: FieldString, Len: 16},
+		{Name: "Time", Kind: FieldString, Len: 16},
+		{Name: "Duration", Kind: FieldUint16},
+		{Name: "TimeWindow", Kind: FieldFloat32},
+		{Name: "DelayWindow", Kind: FieldFloat32},
+		{Name: "XTalkWindow", Kind: FieldFloat32},
+		{Name: "EnergyWindow", Kind: FieldUint32Slice, Len: 2},
+		{Name: "PositionWindow", Kind: FieldUint16},
+		{Name: "Corrected", Kind: FieldUint16},
+		{Name: "TablePosition", Kind: FieldFloat32},
+		{Name: "TableHeight", Kind: FieldFloat32},
+		{Name: "PETCTSpacing", Kind: FieldFloat32},
+		{Name: "TableCount", Kind: FieldUint16},
+		{Name: "TableIndex", Kind: FieldUint16},
+		{Name: "ScanLengthPerTable", Kind: FieldFloat32},
+		{Name: "PatientID", Kind: FieldString, Len: 64},
+		{Name: "StudyID", Kind: FieldString, Len: 64},
+		{Name: "PatientName", Kind: FieldString, Len: 128},
+		{Name: "PatientSex", Kind: FieldString, Len: 8},
+		{Name: "PatientHeight", Kind: FieldFloat32},
+		{Name: "PatientWeight", Kind: FieldFloat32},
+	},
+	ImageInfo: []Field{
+		{Name: "Length", Kind: FieldUint32},
+		{Name: "ImageSizeRows", Kind: FieldUint16},
+		{Name: "ImageSizeCols", Kind: FieldUint16},
+		{Name: "ImageSizeSlices", Kind: FieldUint16},
+		{Name: "ImageRowPixelSize", Kind: FieldFloat32},
+		{Name: "ImageColumnPixelSize", Kind: FieldFloat32},
+		{Name: "ImageSliceThickness", Kind: FieldFloat32},
+		{Name: "ReconMethod", Kind: FieldString, Len: 16},
+		{Name: "MaxRingDiffNum", Kind: FieldUint16},
+		{Name: "SubsetNum", Kind: FieldUint16},
+		{Name: "IterNum", Kind: FieldUint16},
+		{Name: "AttnCalibration", Kind: FieldUint16},
+		{Name: "ScatCalibration", Kind: FieldUint16},
+		{Name: "ScatPara", Kind: FieldFloat32Slice, Len: 6},
+		{Name: "TVPara", Kind: FieldFloat32Slice, Len: 2},
+		{Name: "PetCtFovOffset", Kind: FieldFloat32Slice, Len: 3},
+		{Name: "CtRotationAngle", Kind: FieldFloat32},
+		{Name: "SeriesNumber", Kind: FieldUint16},
+		{Name: "ReconSoftwareVersion", Kind: FieldString, Len: 16},
+		{Name: "PromptsCounts", Kind: FieldUint32},
+		{Name: "DelayCounts", Kind: FieldUint32},
+	},
+	DataInfo: []Field{
+		{Name: "Length", Kind: FieldUint32},
+		{Name: "DataLength", Kind: FieldUint32},
+		{Name: "CRC", Kind: FieldUint16},
+	},
+}
+
+var (
+	layoutsMu sync.RWMutex
+	layouts   = map[string]*Layout{}
+)
+
+// RegisterLayout 注册软件版本对应的文件头布局，version与解析得到的PublicInfo.SoftwareVersion比较。
+// 布局中的字段名必须存在于对应的结构体中且类型匹配
+func RegisterLayout(version string, layout *Layout) error {
+	sections := []struct {
+		name   Section
+		fields []Field
+		target reflect.Type
+	}{
+		{SectionDeviceInfo, layout.DeviceInfo, reflect.TypeOf(DeviceInfo{})},
+		{SectionAcquisitionInfo, layout.AcquisitionInfo, reflect.TypeOf(AcquisitionInfo{})},
+		{SectionImageInfo, layout.ImageInfo, reflect.TypeOf(ImageInfo{})},
+		{SectionDataInfo, layout.DataInfo, reflect.TypeOf(DataInfo{})},
+	}
+	for _, section := range sections {
+		if err := checkFields(section.fields, section.target); err != nil {
+			return fmt.Errorf("layout %q %s: %w", version, section.name, err)
+		}
+	}
+
+	layoutsMu.Lock()
+	defer layoutsMu.Unlock()
+	layouts[version] = layout
+	return nil
+}
+
+// LookupLayout 返回软件版本对应的布局，未注册时返回DefaultLayout
+func LookupLayout(version string) *Layout {
+	layoutsMu.RLock()
+	defer layoutsMu.RUnlock()
+	if layout, ok := layouts[version]; ok {
+		return layout
+	}
+	return DefaultLayout
+}
+
+// layoutFor 按软件版本字段查找布局，字段从第一个空字符处截断并移除末尾空格后再查找，
+// 使解析及写出时的查找结果不受StringMode影响
+func layoutFor(version string) *Layout {
+	return LookupLayout(modifyStringByFirstBlank([]byte(version)))
+}
+
+func checkFields(fields []Field, target reflect.Type) error {
+	for _, f := range fields {
+		if f.Kind == FieldPadding {
+			continue
+		}
+		t, ok := fieldKindTypes[f.Kind]
+		if !ok {
+			return fmt.Errorf("field %s: unknown kind %d", f.Name, f.Kind)
+		}
+		sf, ok := target.FieldByName(f.Name)
+		if !ok {
+			return fmt.Errorf("unknown field %s", f.Name)
+		}
+		if sf.Type != t {
+			return fmt.Errorf("field %s: kind %d does not match type %s", f.Name, f.Kind, sf.Type)
+		}
+	}
+	return nil
+}
+
+// parseFields 按布局依次读取字段并填入target指向的结构体
+func (p *Parser) parseFields(fields []Field, target interface{}) {
+	v := reflect.ValueOf(target).Elem()
+	for _, f := range fields {
+		var value interface{}
+		switch f.Kind {
+		case FieldUint16:
+			value = p.nextUint16()
+		case FieldUint32:
+			value = p.nextUint32()
+		case FieldFloat32:
+			value = p.nextFloat32()
+		case FieldFloat64:
+			value = p.nextFloat64()
+		case FieldString:
+			value = p.nextString(f.Len)
+		case FieldFloat32Slice:
+			value = p.nextFloat32Slice(f.Len)
+		case FieldUint32Slice:
+			value = p.nextUint32Slice(f.Len)
+		case FieldPadding:
+			p.nextUint8Slice(f.Len)
+			continue
+		}
+		v.FieldByName(f.Name).Set(reflect.ValueOf(value))
+	}
+}
+
+// writeFields 按布局依次写出source指向的结构体中的字段
+func (w *Writer) writeFields(fields []Field, source interface{}) {
+	v := reflect.ValueOf(source).Elem()
+	for _, f := range fields {
+		if f.Kind == FieldPadding {
+			w.writeBytes(make([]byte, f.Len))
+			continue
+		}
+		value := v.FieldByName(f.Name)
+		switch f.Kind {
+		case FieldUint16:
+			w.writeUint16(uint16(value.Uint()))
+		case FieldUint32:
+			w.writeUint32(uint32(value.Uint()))
+		case FieldFloat32:
+			w.writeFloat32(float32(value.Float()))
+		case FieldFloat64:
+			w.writeFloat64(value.Float())
+		case FieldString:
+			w.writeString(value.String(), f.Len)
+		case FieldFloat32Slice:
+			w.writeFloat32Slice(value.Interface().([]float32), f.Len)
+		case FieldUint32Slice:
+			w.writeUint32Slice(value.Interface().([]uint32), f.Len)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package dpetk
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestRegisterLayout(t *testing.T) {
+	deviceInfo := append([]Field(nil), DefaultLayout.DeviceInfo...)
+	deviceInfo[2] = Field{Name: "Serial", Kind: FieldString, Len: 32}
+	deviceInfo = append(deviceInfo, Field{Kind: FieldPadding, Len: 4})
+	layout := &Layout{
+		DeviceInfo:      deviceInfo,
+		AcquisitionInfo: DefaultLayout.AcquisitionInfo,
+		ImageInfo:       DefaultLayout.ImageInfo,
+		DataInfo:        DefaultLayout.DataInfo,
+	}
+	if err := RegisterLayout("TEST-LAYOUT-2", layout); err != nil {
+		t.Fatal(err)
+	}
+	if LookupLayout("TEST-LAYOUT-2") != layout || LookupLayout("unknown") != DefaultLayout {
+		t.Fatal("unexpected layout lookup result")
+	}
+
+	dataSet := testDataSet(MichDataType)
+	dataSet.PublicInfo.SoftwareVersion = "TEST-LAYOUT-2"
+	dataSet.DeviceInfo.Serial = "a serial longer than 16 bytes"
+	dataSet.MichData = []uint16{1, 2}
+	buf := bytes.NewBuffer(nil)
+	if err := Write(dataSet, buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != rawHeaderLen+16+4+2*2 {
+		t.Fatalf("unexpected file length %d", buf.Len())
+	}
+	parsed, err := Parse(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(dataSet, parsed) {
+		t.Fatalf("round trip mismatch:\n%+v\n%+v", dataSet.DeviceInfo, parsed.DeviceInfo)
+	}
+
+	// 保留原始字节解析时，软件版本带有填充的空字符，写出时仍应使用注册的布局
+	buf.Reset()
+	if err = Write(dataSet, buf); err != nil {
+		t.Fatal(err)
+	}
+	encoded := buf.Bytes()
+	parsed, err = Parse(bytes.NewReader(encoded), WithStringMode(StringRaw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	again := bytes.NewBuffer(nil)
+	if err = Write(parsed, again); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(encoded, again.Bytes()) {
+		t.Fatal("rewritten file differs from input")
+	}
+}
+
+func TestRegisterInvalidLayout(t *testing.T) {
+	layouts := []*Layout{
+		{DeviceInfo: []Field{{Name: "NoSuchField", Kind: FieldUint16}}},
+		{DeviceInfo: []Field{{Name: "Device", Kind: FieldUint16}}},
+	}
+	for _, layout := range layouts {
+		if err := RegisterLayout("TEST-INVALID", layout); err == nil {
+			t.Fatalf("expected error for layout %+v", layout.DeviceInfo)
+		}
+	}
+	if LookupLayout("TEST-INVALID") != DefaultLayout {
+		t.Fatal("invalid layout must not be registered")
+	}
+}
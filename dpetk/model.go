@@ -1,6 +1,9 @@
 package dpetk
 
-import "bytes"
+import (
+	"bytes"
+	"fmt"
+)
 
 // DataSet 文件数据集
 type DataSet struct {
@@ -14,12 +17,18 @@ type DataSet struct {
 	MichData        []uint16
 	ImageData       []float32
 
+	EnergyCalibration *CalibrationMap
+	TimeCalibration   *CalibrationMap
+	EnergySpectrum    *EnergySpectrum
+
 	// 存储未解析的数据区数据
 	DataBuf *bytes.Buffer
 }
 
 // PublicInfo 1.2.1 公共信息
 type PublicInfo struct {
+	// MagicKey 文件起始的16字节魔数，写出时原样写出，为空时写出包级变量MagicKey
+	MagicKey        []uint8
 	HeaderCRC       uint16
 	Length          uint32
 	Type            uint16
@@ -104,16 +113,94 @@ type DataInfo struct {
 	CRC        uint16
 }
 
+// RawDataItem 原始数据包，数据包内部布局尚无可引用的说明，Data按原始字节保存
 type RawDataItem struct {
 	Data []uint8
-	IP   string
+	IP   DetectorAddr
 }
 
 type ListmodeDataItem struct {
-	IP       string
+	IP       DetectorAddr
 	XTalk    bool
 	Reserved uint8
 	Channel  uint16
 	Energy   float32
 	Time     float64
 }
+
+// ImageVolume 重建图像体数据，按层、行、列的顺序存放，列变化最快
+type ImageVolume struct {
+	Rows   int
+	Cols   int
+	Slices int
+
+	// 像素间距及层厚，单位与ImageInfo一致
+	RowPixelSize    float32
+	ColumnPixelSize float32
+	SliceThickness  float32
+
+	Data []float32
+}
+
+// At 返回第slice层第row行第col列的像素值
+func (v *ImageVolume) At(row, col, slice int) float32 {
+	return v.Data[(slice*v.Rows+row)*v.Cols+col]
+}
+
+// ImageVolume 根据ImageInfo中的尺寸及像素间距组织ImageData
+func (d *DataSet) ImageVolume() (*ImageVolume, error) {
+	if d.ImageInfo == nil {
+		return nil, fmt.Errorf("dataset has no image info")
+	}
+	v := &ImageVolume{
+		Rows:            int(d.ImageInfo.ImageSizeRows),
+		Cols:            int(d.ImageInfo.ImageSizeCols),
+		Slices:          int(d.ImageInfo.ImageSizeSlices),
+		RowPixelSize:    d.ImageInfo.ImageRowPixelSize,
+		ColumnPixelSize: d.ImageInfo.ImageColumnPixelSize,
+		SliceThickness:  d.ImageInfo.ImageSliceThickness,
+		Data:            d.ImageData,
+	}
+	if len(v.Data) != v.Rows*v.Cols*v.Slices {
+		return nil, &ImageSizeError{
+			Rows:     d.ImageInfo.ImageSizeRows,
+			Cols:     d.ImageInfo.ImageSizeCols,
+			Slices:   d.ImageInfo.ImageSizeSlices,
+			Expected: int64(v.Rows*v.Cols*v.Slices) * 4,
+			Actual:   int64(len(v.Data)) * 4,
+		}
+	}
+	return v, nil
+}
+
+// CalibrationMap 按探测器地址和通道组织的校正数据，每个通道有Stride个系数
+type CalibrationMap struct {
+	DetectorRange
+	Stride int
+	Values []float32
+}
+
+// At 返回指定探测器地址和通道的校正系数
+func (m *CalibrationMap) At(addr DetectorAddr, channel uint16) ([]float32, error) {
+	i, err := m.Index(addr, channel)
+	if err != nil {
+		return nil, err
+	}
+	return m.Values[i*m.Stride : (i+1)*m.Stride], nil
+}
+
+// EnergySpectrum 按探测器地址和通道组织的能谱直方图，每个通道有Bins个计数
+type EnergySpectrum struct {
+	DetectorRange
+	Bins   int
+	Counts []uint32
+}
+
+// At 返回指定探测器地址和通道的能谱直方图
+func (s *EnergySpectrum) At(addr DetectorAddr, channel uint16) ([]uint32, error) {
+	i, err := s.Index(addr, channel)
+	if err != nil {
+		return nil, err
+	}
+	return s.Counts[i*s.Bins : (i+1)*s.Bins], nil
+}
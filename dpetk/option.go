@@ -0,0 +1,120 @@
+package dpetk
+
+import "encoding/binary"
+
+// StringMode 定长字符串字段的处理方式
+type StringMode int
+
+const (
+	// StringTrimFirstBlank 从第一个空字符处截断，并移除末尾的空格，默认方式
+	StringTrimFirstBlank StringMode = iota
+	// StringTrimTrailing 仅移除末尾的空字符及空格
+	StringTrimTrailing
+	// StringRaw 保留字段的全部原始字节
+	StringRaw
+)
+
+type ParseOptionSet struct {
+	notParseData   bool
+	onlyHeader     bool
+	verifyChecksum bool
+	byteOrder      binary.ByteOrder
+	stringMode     StringMode
+	subnet         Subnet
+	dataTypes      []uint16
+	maxEvents      int
+}
+
+type ParseOption func(*ParseOptionSet)
+
+func genParseOption(opts ...ParseOption) *ParseOptionSet {
+	option := &ParseOptionSet{
+		byteOrder:  binary.LittleEndian,
+		stringMode: StringTrimFirstBlank,
+		subnet:     DefaultSubnet(),
+	}
+	for _, opt := range opts {
+		opt(option)
+	}
+	return option
+}
+
+// NotParseData 不解析数据区，将数据区直接写入dataset中的buffer
+func NotParseData() ParseOption {
+	return func(set *ParseOptionSet) {
+		set.notParseData = true
+	}
+}
+
+// OnlyParseHeader 只解析文件头，不读取数据区，解析产生的dataset不包含数据信息
+func OnlyParseHeader() ParseOption {
+	return func(set *ParseOptionSet) {
+		set.onlyHeader = true
+	}
+}
+
+// VerifyChecksum 解析时重新计算文件头CRC、数据区CRC及数据区长度，与文件中记录的值不一致时返回错误。
+// 数据区的校验在数据区读取完毕后进行。校验算法尚未与实际文件核对，见CRC16
+func VerifyChecksum() ParseOption {
+	return func(set *ParseOptionSet) {
+		set.verifyChecksum = true
+	}
+}
+
+// WithByteOrder 指定文件的字节序，默认为小端序
+func WithByteOrder(order binary.ByteOrder) ParseOption {
+	return func(set *ParseOptionSet) {
+		set.byteOrder = order
+	}
+}
+
+// WithStringMode 指定定长字符串字段的处理方式，默认为StringTrimFirstBlank
+func WithStringMode(mode StringMode) ParseOption {
+	return func(set *ParseOptionSet) {
+		set.stringMode = mode
+	}
+}
+
+// WithSubnet 指定探测器所在网段，记录在解析得到的DetectorRange中，默认为DefaultSubnet
+func WithSubnet(subnet Subnet) ParseOption {
+	return func(set *ParseOptionSet) {
+		set.subnet = subnet
+	}
+}
+
+// OnlyDataTypes 仅解析指定文件类型的数据区，其它类型的文件只解析文件头
+func OnlyDataTypes(types ...uint16) ParseOption {
+	return func(set *ParseOptionSet) {
+		set.dataTypes = append(set.dataTypes, types...)
+	}
+}
+
+// MaxEvents 原始数据及listmode数据最多读取n条记录，其余记录不再读取。
+// 数据区未读取完毕时不进行数据区校验
+func MaxEvents(n int) ParseOption {
+	return func(set *ParseOptionSet) {
+		set.maxEvents = n
+	}
+}
+
+type WriteOptionSet struct {
+	updateChecksum bool
+}
+
+type WriteOption func(*WriteOptionSet)
+
+func genWriteOption(opts ...WriteOption) *WriteOptionSet {
+	option := &WriteOptionSet{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	return option
+}
+
+// UpdateChecksum 写出时按实际写出的内容重新计算DataInfo.DataLength、DataInfo.CRC及HeaderCRC，
+// 用于修改或合成的文件，dataset本身不会被修改。校验算法尚未与实际文件核对，见CRC16
+func UpdateChecksum() WriteOption {
+	return func(set *WriteOptionSet) {
+		set.updateChecksum = true
+	}
+}
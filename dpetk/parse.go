@@ -1,70 +1,152 @@
 package dpetk
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/binary"
 	"io"
+	"math"
 	"os"
-	"strconv"
 )
 
-func ParseFile(path string, parseData bool) (*DataSet, error) {
+func ParseFile(path string, opts ...ParseOption) (*DataSet, error) {
 	file, err := os.Open(path)
 
 	if err != nil {
 		return nil, err
 	}
-	return Parse(file, parseData)
+	defer file.Close()
+	return Parse(bufio.NewReader(file), opts...)
 }
 
-func Parse(reader io.Reader, parseData bool) (*DataSet, error) {
-	p := &Parser{
-		reader:    reader,
-		byteOrder: binary.LittleEndian,
-		modifyStr: true,
+func Parse(reader io.Reader, opts ...ParseOption) (*DataSet, error) {
+	p := newParser(reader, genParseOption(opts...))
+	return p.parse()
+}
 
-		parseData: parseData,
+func newParser(reader io.Reader, option *ParseOptionSet) *Parser {
+	p := &Parser{
+		reader:     reader,
+		byteOrder:  option.byteOrder,
+		stringMode: option.stringMode,
+		subnet:     option.subnet,
+
+		parseData:  !option.notParseData,
+		onlyHeader: option.onlyHeader,
+		dataTypes:  option.dataTypes,
+		maxEvents:  option.maxEvents,
+	}
+	if option.verifyChecksum {
+		p.checksum = newChecksum()
+		p.reader = io.TeeReader(reader, p.checksum)
 	}
-	return p.parse(), nil
+	return p
 }
 
 type Parser struct {
 	reader    io.Reader
 	byteOrder binary.ByteOrder
 
-	// 是否移除string末尾的空字符
-	modifyStr bool
+	// 定长字符串字段的处理方式
+	stringMode StringMode
+
+	// 探测器所在网段
+	subnet Subnet
 
 	// 是否对数据区进行解析，如不解析则将数据放置在dataset中缓冲区
 	parseData bool
+	// 是否只解析文件头
+	onlyHeader bool
+	// 不为空时只解析其中文件类型的数据区
+	dataTypes []uint16
+	// 大于0时原始数据及listmode数据最多读取的记录条数
+	maxEvents int
+	// 已读取的记录条数
+	events int
+
+	// 已读取的字节数，即下一个字段在输入中的偏移
+	offset int64
+	// 当前正在解析的区段
+	section Section
+	// 解析过程中遇到的第一个错误，出错后的读取均返回零值
+	err error
+
+	// 读取数据区记录时复用的缓冲区
+	record []byte
+
+	// 不为nil时在读取过程中累计校验值
+	checksum *checksum
+
+	// 由PublicInfo中的软件版本确定的文件头布局
+	layout *Layout
 }
 
-func (p *Parser) parse() *DataSet {
-	dataSet := &DataSet{}
-	dataSet.PublicInfo = p.parsePublicInfo()
-	dataSet.DeviceInfo = p.parseDeviceInfo()
-	switch dataSet.PublicInfo.Type {
-	case RawDataType:
-		dataSet.AcquisitionInfo = p.parseAcquisitionInfo()
-		dataSet.DataInfo = p.parseDataInfo()
+func (p *Parser) parse() (*DataSet, error) {
+	dataSet, err := p.parseHeader()
+	if err != nil {
+		return nil, err
+	}
 
-		if p.parseData {
-			dataSet.RawData = p.parseRawData()
+	if p.onlyHeader || !p.acceptDataType(dataSet.PublicInfo.Type) {
+		return dataSet, nil
+	}
+	if !p.parseData {
+		dataSet.DataBuf = bytes.NewBuffer(nil)
+		n, err := io.Copy(dataSet.DataBuf, p.reader)
+		if err != nil {
+			return nil, &ParseError{Section: p.section, Offset: p.offset + n, Err: err}
 		}
+		p.offset += n
+		return dataSet, p.verifyData(dataSet.DataInfo)
+	}
+	switch dataSet.PublicInfo.Type {
+	case RawDataType:
+		dataSet.RawData = p.parseRawData()
 	case ListmodeDataType:
-		dataSet.AcquisitionInfo = p.parseAcquisitionInfo()
-		dataSet.DataInfo = p.parseDataInfo()
+		dataSet.ListmodeData = p.parseListmodeData()
+	case MichDataType:
+		dataSet.MichData = p.parseMichData()
+	case EnergyCalibrationMap:
+		dataSet.EnergyCalibration = p.parseCalibrationMap(dataSet.DeviceInfo)
+	case TimeCalibrationMap:
+		dataSet.TimeCalibration = p.parseCalibrationMap(dataSet.DeviceInfo)
+	case EnergySpectrumData:
+		dataSet.EnergySpectrum = p.parseEnergySpectrum(dataSet.DeviceInfo)
+	default:
+		dataSet.ImageData = p.parseImageData(dataSet.ImageInfo)
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	if p.maxEvents > 0 && p.events >= p.maxEvents {
+		return dataSet, nil
+	}
+	return dataSet, p.verifyData(dataSet.DataInfo)
+}
 
-		if p.parseData {
-			dataSet.ListmodeData = p.parseListmodeData()
+// acceptDataType 判断是否需要读取该文件类型的数据区
+func (p *Parser) acceptDataType(dataType uint16) bool {
+	if len(p.dataTypes) == 0 {
+		return true
+	}
+	for _, t := range p.dataTypes {
+		if t == dataType {
+			return true
 		}
-	case MichDataType:
+	}
+	return false
+}
+
+// parseHeader 解析数据区之前的各个信息区，返回不含数据的dataset
+func (p *Parser) parseHeader() (*DataSet, error) {
+	dataSet := &DataSet{}
+	dataSet.PublicInfo = p.parsePublicInfo()
+	p.layout = layoutFor(dataSet.PublicInfo.SoftwareVersion)
+	dataSet.DeviceInfo = p.parseDeviceInfo()
+	switch dataSet.PublicInfo.Type {
+	case RawDataType, ListmodeDataType, MichDataType:
 		dataSet.AcquisitionInfo = p.parseAcquisitionInfo()
 		dataSet.DataInfo = p.parseDataInfo()
-
-		if p.parseData {
-			dataSet.MichData = p.parseMichData()
-		}
 	case EnergyCalibrationMap:
 		dataSet.DataInfo = p.parseDataInfo()
 	case TimeCalibrationMap:
@@ -76,117 +158,95 @@ func (p *Parser) parse() *DataSet {
 		dataSet.ImageInfo = p.parseImageInfo()
 		dataSet.DataInfo = p.parseDataInfo()
 	}
-	if !p.parseData {
-		dataSet.DataBuf = bytes.NewBuffer(nil)
-		io.Copy(dataSet.DataBuf, p.reader)
+	if p.err != nil {
+		return nil, p.err
 	}
-	return dataSet
+	if p.checksum != nil {
+		if crc := p.checksum.header; crc != dataSet.PublicInfo.HeaderCRC {
+			return nil, &ParseError{
+				Section: SectionPublicInfo,
+				Offset:  16,
+				Err:     &HeaderCRCError{Expected: dataSet.PublicInfo.HeaderCRC, Actual: crc},
+			}
+		}
+		p.checksum.inData = true
+	}
+	p.section = SectionData
+	return dataSet, nil
+}
+
+// verifyData 数据区读取完毕后校验数据区长度及CRC，未开启校验时直接返回
+func (p *Parser) verifyData(info *DataInfo) error {
+	if p.checksum == nil {
+		return nil
+	}
+	start := p.offset - p.checksum.dataLen
+	if p.checksum.dataLen != int64(info.DataLength) {
+		return &ParseError{
+			Section: SectionData,
+			Offset:  start,
+			Err:     &DataLengthError{Expected: info.DataLength, Actual: p.checksum.dataLen},
+		}
+	}
+	if p.checksum.data != info.CRC {
+		return &ParseError{
+			Section: SectionData,
+			Offset:  start,
+			Err:     &DataCRCError{Expected: info.CRC, Actual: p.checksum.data},
+		}
+	}
+	return nil
 }
 
 func (p *Parser) parsePublicInfo() *PublicInfo {
-	// skip magic keys
-	_, _ = p.nextString(16)
+	p.section = SectionPublicInfo
+	magicKey := p.nextUint8Slice(len(MagicKey))
 	return &PublicInfo{
-		HeaderCRC:       p.mustNextUint16(),
-		Length:          p.mustNextUint32(),
-		Type:            p.mustNextUint16(),
-		SoftwareVersion: p.mustNextString(16),
-		HeaderLength:    p.mustNextUint32(),
+		MagicKey:        magicKey,
+		HeaderCRC:       p.nextUint16(),
+		Length:          p.nextUint32(),
+		Type:            p.nextUint16(),
+		SoftwareVersion: p.nextString(16),
+		HeaderLength:    p.nextUint32(),
 	}
 }
 
 func (p *Parser) parseDeviceInfo() *DeviceInfo {
-	return &DeviceInfo{
-		Length:            p.mustNextUint32(),
-		Device:            p.mustNextString(16),
-		Serial:            p.mustNextString(16),
-		AxisDetectors:     p.mustNextUint16(),
-		TransDetectors:    p.mustNextUint16(),
-		DetectorsRings:    p.mustNextUint16(),
-		DetectorsChannels: p.mustNextUint16(),
-		IpCounts:          p.mustNextUint16(),
-		IpStart:           p.mustNextUint16(),
-		ChannelCounts:     p.mustNextUint16(),
-		ChannelStart:      p.mustNextUint16(),
-		MvtThresholds:     p.mustNextFloat32Slice(8),
-		MvtParameters:     p.mustNextFloat32Slice(3),
-	}
+	p.section = SectionDeviceInfo
+	info := &DeviceInfo{}
+	p.parseFields(p.layout.DeviceInfo, info)
+	return info
 }
 
 func (p *Parser) parseAcquisitionInfo() *AcquisitionInfo {
-	return &AcquisitionInfo{
-		Length:             p.mustNextUint32(),
-		Isotope:            p.mustNextUint16(),
-		Activity:           p.mustNextFloat32(),
-		InjectTime:         p.mustNextString(16),
-		Time:               p.mustNextString(16),
-		Duration:           p.mustNextUint16(),
-		TimeWindow:         p.mustNextFloat32(),
-		DelayWindow:        p.mustNextFloat32(),
-		XTalkWindow:        p.mustNextFloat32(),
-		EnergyWindow:       []uint32{p.mustNextUint32(), p.mustNextUint32()},
-		PositionWindow:     p.mustNextUint16(),
-		Corrected:          p.mustNextUint16(),
-		TablePosition:      p.mustNextFloat32(),
-		TableHeight:        p.mustNextFloat32(),
-		PETCTSpacing:       p.mustNextFloat32(),
-		TableCount:         p.mustNextUint16(),
-		TableIndex:         p.mustNextUint16(),
-		ScanLengthPerTable: p.mustNextFloat32(),
-		PatientID:          p.mustNextString(64),
-		StudyID:            p.mustNextString(64),
-		PatientName:        p.mustNextString(128),
-		PatientSex:         p.mustNextString(8),
-		PatientHeight:      p.mustNextFloat32(),
-		PatientWeight:      p.mustNextFloat32(),
-	}
+	p.section = SectionAcquisitionInfo
+	info := &AcquisitionInfo{}
+	p.parseFields(p.layout.AcquisitionInfo, info)
+	return info
 }
 
 func (p *Parser) parseImageInfo() *ImageInfo {
-	return &ImageInfo{
-		Length:               p.mustNextUint32(),
-		ImageSizeRows:        p.mustNextUint16(),
-		ImageSizeCols:        p.mustNextUint16(),
-		ImageSizeSlices:      p.mustNextUint16(),
-		ImageRowPixelSize:    p.mustNextFloat32(),
-		ImageColumnPixelSize: p.mustNextFloat32(),
-		ImageSliceThickness:  p.mustNextFloat32(),
-		ReconMethod:          p.mustNextString(16),
-		MaxRingDiffNum:       p.mustNextUint16(),
-		SubsetNum:            p.mustNextUint16(),
-		IterNum:              p.mustNextUint16(),
-		AttnCalibration:      p.mustNextUint16(),
-		ScatCalibration:      p.mustNextUint16(),
-		ScatPara:             p.mustNextFloat32Slice(6),
-		TVPara:               p.mustNextFloat32Slice(2),
-		PetCtFovOffset:       p.mustNextFloat32Slice(3),
-		CtRotationAngle:      p.mustNextFloat32(),
-		SeriesNumber:         p.mustNextUint16(),
-		ReconSoftwareVersion: p.mustNextString(16),
-		PromptsCounts:        p.mustNextUint32(),
-		DelayCounts:          p.mustNextUint32(),
-	}
+	p.section = SectionImageInfo
+	info := &ImageInfo{}
+	p.parseFields(p.layout.ImageInfo, info)
+	return info
 }
 
 func (p *Parser) parseDataInfo() *DataInfo {
-	return &DataInfo{
-		Length:     p.mustNextUint32(),
-		DataLength: p.mustNextUint32(),
-		CRC:        p.mustNextUint16(),
-	}
+	p.section = SectionDataInfo
+	info := &DataInfo{}
+	p.parseFields(p.layout.DataInfo, info)
+	return info
 }
 
 func (p *Parser) parseRawData() []RawDataItem {
 	var res []RawDataItem
 	for {
-		data, err := p.nextUint8Slice(1152)
-		if err != nil {
+		item, ok := p.nextRawDataItem()
+		if !ok {
 			break
 		}
-		res = append(res, RawDataItem{
-			Data: data,
-			IP:   toIPStr(p.mustNextUint16()),
-		})
+		res = append(res, item)
 	}
 	return res
 }
@@ -194,19 +254,11 @@ func (p *Parser) parseRawData() []RawDataItem {
 func (p *Parser) parseListmodeData() []ListmodeDataItem {
 	var res []ListmodeDataItem
 	for {
-		ip, err := p.nextUint16()
-		if err != nil {
+		item, ok := p.nextListmodeDataItem()
+		if !ok {
 			break
 		}
-		ch := p.mustNextUint16()
-		res = append(res, ListmodeDataItem{
-			IP:       toIPStr(ip),
-			XTalk:    ch&(1<<15) != 0,
-			Reserved: uint8((ch >> 12) & (1<<3 - 1)),
-			Channel:  ch & (1<<12 - 1),
-			Energy:   p.mustNextFloat32(),
-			Time:     p.mustNextFloat64(),
-		})
+		res = append(res, item)
 	}
 	return res
 }
@@ -214,8 +266,8 @@ func (p *Parser) parseListmodeData() []ListmodeDataItem {
 func (p *Parser) parseMichData() []uint16 {
 	var res []uint16
 	for {
-		v, err := p.nextUint16()
-		if err != nil {
+		v, ok := p.nextMichValue()
+		if !ok {
 			break
 		}
 		res = append(res, v)
@@ -223,119 +275,261 @@ func (p *Parser) parseMichData() []uint16 {
 	return res
 }
 
-func (p *Parser) nextUint16() (uint16, error) {
-	var res uint16
-	err := binary.Read(p.reader, p.byteOrder, &res)
-	if err != nil {
-		return 0, err
+// parseImageData 按ImageInfo中声明的尺寸读取图像体数据，数据区长度与尺寸不一致时记录ImageSizeError。
+// 尺寸来自文件头，不可信，因此按实际读到的数据增长缓冲区，而不是按声明尺寸预先分配
+func (p *Parser) parseImageData(info *ImageInfo) []float32 {
+	expected := int64(info.ImageSizeRows) * int64(info.ImageSizeCols) * int64(info.ImageSizeSlices) * 4
+	start := p.offset
+	buf := bytes.NewBuffer(nil)
+	got, err := io.CopyN(buf, p.reader, expected)
+	p.offset += got
+	if err == nil {
+		// 声明尺寸之后不应再有数据
+		var rest int64
+		rest, err = io.Copy(io.Discard, p.reader)
+		p.offset += rest
+		if err == nil && rest == 0 {
+			bs := buf.Bytes()
+			res := make([]float32, len(bs)/4)
+			for i := range res {
+				res[i] = math.Float32frombits(p.byteOrder.Uint32(bs[i*4:]))
+			}
+			return res
+		}
+	}
+	if err == nil || err == io.EOF {
+		err = &ImageSizeError{
+			Rows:     info.ImageSizeRows,
+			Cols:     info.ImageSizeCols,
+			Slices:   info.ImageSizeSlices,
+			Expected: expected,
+			Actual:   p.offset - start,
+		}
 	}
-	return res, nil
+	p.err = &ParseError{Section: p.section, Offset: start, Err: err}
+	return nil
 }
 
-func (p *Parser) nextUint32() (uint32, error) {
-	var res uint32
-	err := binary.Read(p.reader, p.byteOrder, &res)
-	if err != nil {
-		return 0, err
+// parseCalibrationMap 读取按IP、通道排列的float32校正系数，每个通道的系数个数由数据区长度确定
+func (p *Parser) parseCalibrationMap(info *DeviceInfo) *CalibrationMap {
+	r := p.detectorRange(info)
+	bs := p.readChannelData(r)
+	if bs == nil {
+		return nil
+	}
+	values := make([]float32, len(bs)/4)
+	for i := range values {
+		values[i] = math.Float32frombits(p.byteOrder.Uint32(bs[i*4:]))
 	}
-	return res, nil
+	return &CalibrationMap{DetectorRange: r, Stride: len(values) / r.Channels(), Values: values}
 }
 
-func (p *Parser) nextFloat32() (float32, error) {
-	var res float32
-	err := binary.Read(p.reader, p.byteOrder, &res)
-	if err != nil {
-		return 0, err
+// parseEnergySpectrum 读取按IP、通道排列的uint32能谱计数，每个通道的道数由数据区长度确定
+func (p *Parser) parseEnergySpectrum(info *DeviceInfo) *EnergySpectrum {
+	r := p.detectorRange(info)
+	bs := p.readChannelData(r)
+	if bs == nil {
+		return nil
+	}
+	counts := make([]uint32, len(bs)/4)
+	for i := range counts {
+		counts[i] = p.byteOrder.Uint32(bs[i*4:])
 	}
-	return res, nil
+	return &EnergySpectrum{DetectorRange: r, Bins: len(counts) / r.Channels(), Counts: counts}
 }
 
-func (p *Parser) nextFloat64() (float64, error) {
-	var res float64
-	err := binary.Read(p.reader, p.byteOrder, &res)
+// detectorRange 构造位于解析时指定网段的探测器范围
+func (p *Parser) detectorRange(info *DeviceInfo) DetectorRange {
+	r := NewDetectorRange(info)
+	r.Subnet = p.subnet
+	return r
+}
+
+// readChannelData 读取整个数据区，并检查其能否均分为每个通道若干个4字节数值
+func (p *Parser) readChannelData(r DetectorRange) []byte {
+	start := p.offset
+	bs, err := io.ReadAll(p.reader)
+	p.offset += int64(len(bs))
+	if err == nil && (r.Channels() == 0 || len(bs)%(4*r.Channels()) != 0) {
+		err = &ChannelDataSizeError{Channels: r.Channels(), Actual: int64(len(bs))}
+	}
 	if err != nil {
-		return 0, err
+		p.err = &ParseError{Section: p.section, Offset: start, Err: err}
+		return nil
 	}
-	return res, nil
+	return bs
 }
 
-func (p *Parser) nextString(l int) (string, error) {
-	res := make([]byte, l)
-	err := binary.Read(p.reader, p.byteOrder, &res)
-	if err != nil {
-		return "", err
+// nextRawDataItem 读取一条原始数据记录：1152字节数据包及2字节IP
+func (p *Parser) nextRawDataItem() (RawDataItem, bool) {
+	record := p.recordBuf(rawDataItemLen)
+	if !p.nextEvent(record) {
+		return RawDataItem{}, false
 	}
-	if p.modifyStr {
-		return modifyStringByFirstBlank(res), nil
+	data := make([]uint8, rawDataPacketLen)
+	copy(data, record)
+	return RawDataItem{
+		Data: data,
+		IP:   DetectorAddr(p.byteOrder.Uint16(record[rawDataPacketLen:])),
+	}, true
+}
+
+// nextListmodeDataItem 读取一条listmode记录
+func (p *Parser) nextListmodeDataItem() (ListmodeDataItem, bool) {
+	record := p.recordBuf(listmodeDataItemLen)
+	if !p.nextEvent(record) {
+		return ListmodeDataItem{}, false
 	}
-	return string(res), nil
+	return decodeListmodeRecord(p.byteOrder, record), true
 }
 
-func (p *Parser) nextFloat32Slice(l int) ([]float32, error) {
-	res := make([]float32, l)
-	for i := range res {
-		v, err := p.nextFloat32()
-		if err != nil {
-			return nil, err
-		}
-		res[i] = v
+// decodeListmodeRecord 解析一条listmode记录
+func decodeListmodeRecord(order binary.ByteOrder, record []byte) ListmodeDataItem {
+	ch := order.Uint16(record[2:])
+	return ListmodeDataItem{
+		IP:       DetectorAddr(order.Uint16(record)),
+		XTalk:    ch&(1<<15) != 0,
+		Reserved: uint8((ch >> 12) & (1<<3 - 1)),
+		Channel:  ch & (1<<12 - 1),
+		Energy:   math.Float32frombits(order.Uint32(record[4:])),
+		Time:     math.Float64frombits(order.Uint64(record[8:])),
 	}
-	return res, nil
 }
 
-func (p *Parser) nextUint8Slice(l int) ([]uint8, error) {
-	res := make([]uint8, l)
-	err := binary.Read(p.reader, p.byteOrder, &res)
-	if err != nil {
-		return nil, err
+// nextMichValue 读取一个mich计数值
+func (p *Parser) nextMichValue() (uint16, bool) {
+	record := p.recordBuf(michDataItemLen)
+	if !p.nextRecord(record) {
+		return 0, false
 	}
-	return res, nil
+	return p.byteOrder.Uint16(record), true
 }
 
-func (p *Parser) mustNextUint16() uint16 {
-	res, err := p.nextUint16()
-	if err != nil {
-		panic(err)
+// recordBuf 返回长度为l的复用缓冲区，用于读取数据区记录
+func (p *Parser) recordBuf(l int) []byte {
+	if cap(p.record) < l {
+		p.record = make([]byte, l)
 	}
-	return res
+	return p.record[:l]
 }
 
-func (p *Parser) mustNextUint32() uint32 {
-	res, err := p.nextUint32()
+// read 读取len(bs)个字节，失败时记录带有区段和偏移信息的错误
+func (p *Parser) read(bs []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+	n, err := io.ReadFull(p.reader, bs)
 	if err != nil {
-		panic(err)
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		p.err = &ParseError{Section: p.section, Offset: p.offset, Err: err}
 	}
-	return res
+	p.offset += int64(n)
+	return p.err
 }
 
-func (p *Parser) mustNextFloat32() float32 {
-	res, err := p.nextFloat32()
-	if err != nil {
-		panic(err)
+// nextEvent 读取一条事件记录，达到最大记录条数时返回false
+func (p *Parser) nextEvent(record []byte) bool {
+	if p.maxEvents > 0 && p.events >= p.maxEvents {
+		return false
 	}
-	return res
+	if !p.nextRecord(record) {
+		return false
+	}
+	p.events++
+	return true
 }
 
-func (p *Parser) mustNextFloat32Slice(l int) []float32 {
-	res, err := p.nextFloat32Slice(l)
-	if err != nil {
-		panic(err)
+// nextRecord 读取数据区中的一条完整记录，数据区正常结束时返回false，
+// 末尾存在不完整记录时记录PartialRecordError
+func (p *Parser) nextRecord(record []byte) bool {
+	if p.err != nil {
+		return false
+	}
+	n, err := io.ReadFull(p.reader, record)
+	switch err {
+	case nil:
+		p.offset += int64(n)
+		return true
+	case io.EOF:
+		return false
+	case io.ErrUnexpectedEOF:
+		err = &PartialRecordError{RecordSize: len(record), Remaining: n}
+	}
+	p.err = &ParseError{Section: p.section, Offset: p.offset, Err: err}
+	p.offset += int64(n)
+	return false
+}
+
+func (p *Parser) nextUint16() uint16 {
+	var bs [2]byte
+	if p.read(bs[:]) != nil {
+		return 0
+	}
+	return p.byteOrder.Uint16(bs[:])
+}
+
+func (p *Parser) nextUint32() uint32 {
+	var bs [4]byte
+	if p.read(bs[:]) != nil {
+		return 0
+	}
+	return p.byteOrder.Uint32(bs[:])
+}
+
+func (p *Parser) nextFloat32() float32 {
+	return math.Float32frombits(p.nextUint32())
+}
+
+func (p *Parser) nextFloat64() float64 {
+	var bs [8]byte
+	if p.read(bs[:]) != nil {
+		return 0
+	}
+	return math.Float64frombits(p.byteOrder.Uint64(bs[:]))
+}
+
+func (p *Parser) nextString(l int) string {
+	res := make([]byte, l)
+	if p.read(res) != nil {
+		return ""
+	}
+	switch p.stringMode {
+	case StringTrimFirstBlank:
+		return modifyStringByFirstBlank(res)
+	case StringTrimTrailing:
+		return modifyString(res)
+	}
+	return string(res)
+}
+
+func (p *Parser) nextFloat32Slice(l int) []float32 {
+	res := make([]float32, l)
+	for i := range res {
+		res[i] = p.nextFloat32()
+	}
+	if p.err != nil {
+		return nil
 	}
 	return res
 }
 
-func (p *Parser) mustNextString(l int) string {
-	res, err := p.nextString(l)
-	if err != nil {
-		panic(err)
+func (p *Parser) nextUint32Slice(l int) []uint32 {
+	res := make([]uint32, l)
+	for i := range res {
+		res[i] = p.nextUint32()
+	}
+	if p.err != nil {
+		return nil
 	}
 	return res
 }
 
-func (p *Parser) mustNextFloat64() float64 {
-	res, err := p.nextFloat64()
-	if err != nil {
-		panic(err)
+func (p *Parser) nextUint8Slice(l int) []uint8 {
+	res := make([]uint8, l)
+	if p.read(res) != nil {
+		return nil
 	}
 	return res
 }
@@ -370,11 +564,3 @@ func modifyStringByFirstBlank(bs []byte) string {
 	}
 	return string(bs[:i+1])
 }
-
-func toIPStr(ip uint16) string {
-	bs := []byte(ipPrefix)
-	bs = append(bs, []byte(strconv.Itoa(int(ip>>8)))...)
-	bs = append(bs, '.')
-	bs = append(bs, []byte(strconv.Itoa(int(ip&(1<<8-1))))...)
-	return string(bs)
-}
@@ -3,7 +3,10 @@ package dpetk
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
+	"reflect"
 	"testing"
 )
 
@@ -23,13 +26,251 @@ func TestParser(t *testing.T) {
 			// string: " [nil]"
 			0x20, 0x00, 0x00,
 		}),
-		byteOrder: binary.BigEndian,
-		modifyStr: true,
-	}
-	fmt.Println(p.mustNextUint16())
-	fmt.Println(p.mustNextUint32())
-	fmt.Println(p.mustNextFloat32())
-	fmt.Println(p.mustNextFloat32Slice(2))
-	fmt.Println(p.mustNextString(7))
-	fmt.Println(p.mustNextString(3))
+		byteOrder:  binary.BigEndian,
+		stringMode: StringTrimFirstBlank,
+	}
+	fmt.Println(p.nextUint16())
+	fmt.Println(p.nextUint32())
+	fmt.Println(p.nextFloat32())
+	fmt.Println(p.nextFloat32Slice(2))
+	fmt.Println(p.nextString(7))
+	fmt.Println(p.nextString(3))
+	if p.err != nil {
+		t.Fatal(p.err)
+	}
+
+	// 输入已读完，继续读取应记录错误而非panic
+	p.nextUint16()
+	var parseErr *ParseError
+	if !errors.As(p.err, &parseErr) || parseErr.Offset != 28 || !errors.Is(p.err, io.ErrUnexpectedEOF) {
+		t.Fatalf("unexpected error: %v", p.err)
+	}
+}
+
+// rawHeaderLen 原始数据文件（PublicInfo、DeviceInfo、AcquisitionInfo、DataInfo）文件头长度
+const rawHeaderLen = 44 + 96 + 360 + 10
+
+func TestParseTruncatedHeader(t *testing.T) {
+	_, err := Parse(bytes.NewReader(testFile(RawDataType, nil)[:50]))
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected ParseError, got %v", err)
+	}
+	if parseErr.Section != SectionDeviceInfo || parseErr.Offset != 48 {
+		t.Fatalf("unexpected error location: %v", err)
+	}
+}
+
+func TestParsePartialRecord(t *testing.T) {
+	input := testFile(RawDataType, make([]byte, 1154+5))
+	_, err := Parse(bytes.NewReader(input))
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Section != SectionData || parseErr.Offset != rawHeaderLen+1154 {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var partialErr *PartialRecordError
+	if !errors.As(err, &partialErr) || partialErr.RecordSize != 1154 || partialErr.Remaining != 5 {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dataSet, err := Parse(bytes.NewReader(input[:rawHeaderLen+1154]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dataSet.RawData) != 1 {
+		t.Fatalf("expected 1 raw data item, got %d", len(dataSet.RawData))
+	}
+}
+
+func TestParseMagicKey(t *testing.T) {
+	input := testFile(MichDataType, nil)
+	copy(input, "vendor magic key")
+	// 解析时不检查魔数，原样保存
+	dataSet, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(dataSet.PublicInfo.MagicKey) != "vendor magic key" {
+		t.Fatalf("unexpected magic key: %q", dataSet.PublicInfo.MagicKey)
+	}
+}
+
+// testFile 构造一个除魔数和文件类型外文件头字段全为零的文件，数据区为data
+func testFile(fileType uint16, data []byte) []byte {
+	bs := make([]byte, rawHeaderLen, rawHeaderLen+len(data))
+	copy(bs, MagicKey[:])
+	binary.LittleEndian.PutUint16(bs[22:], fileType)
+	return append(bs, data...)
+}
+
+// testListmodeData 构造n条listmode记录，第i条记录的通道号为i
+func testListmodeData(n int) []byte {
+	var bs []byte
+	for i := 0; i < n; i++ {
+		record := make([]byte, listmodeDataItemLen)
+		binary.LittleEndian.PutUint16(record, 0x0102)
+		binary.LittleEndian.PutUint16(record[2:], uint16(i)|1<<15)
+		bs = append(bs, record...)
+	}
+	return bs
+}
+
+func testImageDataSet() *DataSet {
+	dataSet := testDataSet(ImageDataType)
+	dataSet.ImageInfo = &ImageInfo{
+		Length:               100,
+		ImageSizeRows:        2,
+		ImageSizeCols:        3,
+		ImageSizeSlices:      4,
+		ImageRowPixelSize:    1.5,
+		ImageColumnPixelSize: 1.5,
+		ImageSliceThickness:  2,
+		ReconMethod:          "OSEM",
+		ScatPara:             []float32{1, 2, 3, 4, 5, 6},
+		TVPara:               []float32{1, 2},
+		PetCtFovOffset:       []float32{1, 2, 3},
+	}
+	dataSet.ImageData = make([]float32, 2*3*4)
+	for i := range dataSet.ImageData {
+		dataSet.ImageData[i] = float32(i)
+	}
+	return dataSet
+}
+
+func TestParseImageData(t *testing.T) {
+	dataSet := testImageDataSet()
+	buf := bytes.NewBuffer(nil)
+	if err := Write(dataSet, buf); err != nil {
+		t.Fatal(err)
+	}
+	encoded := buf.Bytes()
+
+	parsed, err := Parse(bytes.NewReader(encoded))
+	if err != nil {
+		t.Fatal(err)
+	}
+	volume, err := parsed.ImageVolume()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if volume.At(1, 2, 3) != 23 || volume.SliceThickness != 2 {
+		t.Fatalf("unexpected volume: %+v", volume)
+	}
+
+	for _, input := range [][]byte{encoded[:len(encoded)-4], append(encoded, 0)} {
+		_, err = Parse(bytes.NewReader(input))
+		var sizeErr *ImageSizeError
+		if !errors.As(err, &sizeErr) || sizeErr.Expected != 96 || sizeErr.Actual == 96 {
+			t.Fatalf("expected ImageSizeError, got %v", err)
+		}
+	}
+
+	// 声明的尺寸远大于实际数据时不按声明尺寸分配内存
+	dataSet.ImageInfo.ImageSizeRows, dataSet.ImageInfo.ImageSizeCols, dataSet.ImageInfo.ImageSizeSlices = 0xffff, 0xffff, 0xffff
+	buf.Reset()
+	if err = Write(dataSet, buf); err != nil {
+		t.Fatal(err)
+	}
+	_, err = Parse(buf)
+	var sizeErr *ImageSizeError
+	if !errors.As(err, &sizeErr) || sizeErr.Expected != 0xffff*0xffff*0xffff*4 || sizeErr.Actual != 96 {
+		t.Fatalf("expected ImageSizeError, got %v", err)
+	}
+}
+
+func TestParseCalibration(t *testing.T) {
+	dataSet := testDataSet(TimeCalibrationMap)
+	dataSet.AcquisitionInfo = nil
+	dataSet.DeviceInfo.IpStart, dataSet.DeviceInfo.IpCounts = 0x0101, 2
+	dataSet.DeviceInfo.ChannelStart, dataSet.DeviceInfo.ChannelCounts = 0, 3
+	values := make([]float32, 2*3*2)
+	for i := range values {
+		values[i] = float32(i)
+	}
+	dataSet.TimeCalibration = &CalibrationMap{DetectorRange: NewDetectorRange(dataSet.DeviceInfo), Stride: 2, Values: values}
+
+	buf := bytes.NewBuffer(nil)
+	if err := Write(dataSet, buf); err != nil {
+		t.Fatal(err)
+	}
+	encoded := buf.Bytes()
+	parsed, err := Parse(bytes.NewReader(encoded))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(dataSet, parsed) {
+		t.Fatalf("round trip mismatch:\n%+v\n%+v", dataSet.TimeCalibration, parsed.TimeCalibration)
+	}
+	coefficients, err := parsed.TimeCalibration.At(0x0102, 1)
+	if err != nil || !reflect.DeepEqual(coefficients, []float32{8, 9}) {
+		t.Fatalf("unexpected coefficients: %v %v", coefficients, err)
+	}
+	var rangeErr *DetectorRangeError
+	if _, err = parsed.TimeCalibration.At(0x0103, 0); !errors.As(err, &rangeErr) {
+		t.Fatalf("expected DetectorRangeError, got %v", err)
+	}
+	parsed, err = Parse(bytes.NewReader(encoded), WithSubnet(Subnet{10, 1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ip := parsed.TimeCalibration.IP(0x0102); ip.String() != "10.1.1.2" {
+		t.Fatalf("unexpected ip %v", ip)
+	}
+
+	var sizeErr *ChannelDataSizeError
+	if _, err = Parse(bytes.NewReader(encoded[:len(encoded)-4])); !errors.As(err, &sizeErr) {
+		t.Fatalf("expected ChannelDataSizeError, got %v", err)
+	}
+}
+
+func TestParseEnergySpectrum(t *testing.T) {
+	dataSet := testDataSet(EnergySpectrumData)
+	dataSet.AcquisitionInfo = nil
+	dataSet.DeviceInfo.IpCounts, dataSet.DeviceInfo.ChannelCounts = 1, 2
+	dataSet.EnergySpectrum = &EnergySpectrum{
+		DetectorRange: NewDetectorRange(dataSet.DeviceInfo),
+		Bins:          3,
+		Counts:        []uint32{1, 2, 3, 4, 5, 6},
+	}
+	buf := bytes.NewBuffer(nil)
+	if err := Write(dataSet, buf); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := Parse(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts, err := parsed.EnergySpectrum.At(DetectorAddr(dataSet.DeviceInfo.IpStart), 1)
+	if err != nil || !reflect.DeepEqual(counts, []uint32{4, 5, 6}) {
+		t.Fatalf("unexpected spectrum: %v %v", counts, err)
+	}
+}
+
+func TestParseOptions(t *testing.T) {
+	input := testFile(ListmodeDataType, testListmodeData(5))
+
+	dataSet, err := Parse(bytes.NewReader(input), OnlyParseHeader())
+	if err != nil || dataSet.ListmodeData != nil || dataSet.DataBuf != nil {
+		t.Fatalf("unexpected header-only result: %v %+v", err, dataSet)
+	}
+
+	dataSet, err = Parse(bytes.NewReader(input), OnlyDataTypes(RawDataType, MichDataType))
+	if err != nil || dataSet.ListmodeData != nil || dataSet.DataBuf != nil {
+		t.Fatalf("unexpected filtered result: %v %+v", err, dataSet)
+	}
+	dataSet, err = Parse(bytes.NewReader(input), OnlyDataTypes(ListmodeDataType))
+	if err != nil || len(dataSet.ListmodeData) != 5 {
+		t.Fatalf("unexpected filtered result: %v %+v", err, dataSet)
+	}
+
+	file := withChecksum(input, rawHeaderLen)
+	dataSet, err = Parse(bytes.NewReader(file), MaxEvents(2), VerifyChecksum())
+	if err != nil || len(dataSet.ListmodeData) != 2 {
+		t.Fatalf("unexpected max events result: %v %+v", err, dataSet)
+	}
+
+	dataSet, err = Parse(bytes.NewReader(testFile(MichDataType, nil)), WithStringMode(StringRaw))
+	if err != nil || dataSet.DeviceInfo.Device != string(make([]byte, 16)) {
+		t.Fatalf("unexpected raw string result: %v %q", err, dataSet.DeviceInfo.Device)
+	}
 }
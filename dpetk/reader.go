@@ -0,0 +1,168 @@
+package dpetk
+
+import (
+	"bufio"
+	"io"
+	"os"
+)
+
+// Reader 930文件流式读取器，读取文件头后按条或按批读取数据区记录，不会将整个数据区载入内存
+type Reader struct {
+	p       *Parser
+	dataSet *DataSet
+	closer  io.Closer
+
+	// 数据区是否已完成校验
+	verified bool
+}
+
+// OpenFile 打开文件并读取文件头，使用完毕后需调用Close
+func OpenFile(path string, opts ...ParseOption) (*Reader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewReader(file, opts...)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	r.closer = file
+	return r, nil
+}
+
+// NewReader 从reader中读取文件头，数据区留待Next/Read系列方法读取。
+// 开启VerifyChecksum时，数据区的校验结果在读取到数据区末尾时返回；
+// NotParseData、OnlyParseHeader及OnlyDataTypes对Reader无效
+func NewReader(reader io.Reader, opts ...ParseOption) (*Reader, error) {
+	p := newParser(bufio.NewReader(reader), genParseOption(opts...))
+	dataSet, err := p.parseHeader()
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{p: p, dataSet: dataSet}, nil
+}
+
+// Header 返回文件头信息，返回的dataset不包含数据区
+func (r *Reader) Header() *DataSet {
+	return r.dataSet
+}
+
+// Close 关闭由OpenFile打开的文件
+func (r *Reader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
+}
+
+// NextRaw 读取下一条原始数据记录，数据区结束时返回io.EOF
+func (r *Reader) NextRaw() (RawDataItem, error) {
+	if err := r.checkType(RawDataType); err != nil {
+		return RawDataItem{}, err
+	}
+	item, ok := r.p.nextRawDataItem()
+	if !ok {
+		return RawDataItem{}, r.end()
+	}
+	return item, nil
+}
+
+// ReadRaw 读取至多len(items)条原始数据记录，返回读取的条数，数据区结束时返回io.EOF
+func (r *Reader) ReadRaw(items []RawDataItem) (int, error) {
+	if err := r.checkType(RawDataType); err != nil {
+		return 0, err
+	}
+	for i := range items {
+		item, ok := r.p.nextRawDataItem()
+		if !ok {
+			return i, r.batchEnd(i)
+		}
+		items[i] = item
+	}
+	return len(items), nil
+}
+
+// NextListmode 读取下一条listmode记录，数据区结束时返回io.EOF
+func (r *Reader) NextListmode() (ListmodeDataItem, error) {
+	if err := r.checkType(ListmodeDataType); err != nil {
+		return ListmodeDataItem{}, err
+	}
+	item, ok := r.p.nextListmodeDataItem()
+	if !ok {
+		return ListmodeDataItem{}, r.end()
+	}
+	return item, nil
+}
+
+// ReadListmode 读取至多len(items)条listmode记录，返回读取的条数，数据区结束时返回io.EOF
+func (r *Reader) ReadListmode(items []ListmodeDataItem) (int, error) {
+	if err := r.checkType(ListmodeDataType); err != nil {
+		return 0, err
+	}
+	for i := range items {
+		item, ok := r.p.nextListmodeDataItem()
+		if !ok {
+			return i, r.batchEnd(i)
+		}
+		items[i] = item
+	}
+	return len(items), nil
+}
+
+// NextMich 读取下一个mich计数值，数据区结束时返回io.EOF
+func (r *Reader) NextMich() (uint16, error) {
+	if err := r.checkType(MichDataType); err != nil {
+		return 0, err
+	}
+	v, ok := r.p.nextMichValue()
+	if !ok {
+		return 0, r.end()
+	}
+	return v, nil
+}
+
+// ReadMich 读取至多len(values)个mich计数值，返回读取的个数，数据区结束时返回io.EOF
+func (r *Reader) ReadMich(values []uint16) (int, error) {
+	if err := r.checkType(MichDataType); err != nil {
+		return 0, err
+	}
+	for i := range values {
+		v, ok := r.p.nextMichValue()
+		if !ok {
+			return i, r.batchEnd(i)
+		}
+		values[i] = v
+	}
+	return len(values), nil
+}
+
+func (r *Reader) checkType(dataType uint16) error {
+	if r.dataSet.PublicInfo.Type != dataType {
+		return &DataTypeError{Expected: dataType, Actual: r.dataSet.PublicInfo.Type}
+	}
+	return nil
+}
+
+// end 返回记录读取失败的原因，数据区正常结束时为io.EOF
+func (r *Reader) end() error {
+	if r.p.err != nil {
+		return r.p.err
+	}
+	if p := r.p; !r.verified && (p.maxEvents == 0 || p.events < p.maxEvents) {
+		r.verified = true
+		if err := r.p.verifyData(r.dataSet.DataInfo); err != nil {
+			r.p.err = err
+			return err
+		}
+	}
+	return io.EOF
+}
+
+// batchEnd 批量读取提前结束时的返回值，已读取部分记录且数据区正常结束时不返回错误
+func (r *Reader) batchEnd(n int) error {
+	if r.p.err == nil && n > 0 {
+		return nil
+	}
+	return r.end()
+}
@@ -0,0 +1,56 @@
+package dpetk
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestReaderListmode(t *testing.T) {
+	r, err := NewReader(bytes.NewReader(testFile(ListmodeDataType, testListmodeData(5))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	item, err := r.NextListmode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Channel != 0 || !item.XTalk || item.IP.String() != "192.168.1.2" {
+		t.Fatalf("unexpected item: %+v", item)
+	}
+
+	batch := make([]ListmodeDataItem, 3)
+	n, err := r.ReadListmode(batch)
+	if err != nil || n != 3 || batch[2].Channel != 3 {
+		t.Fatalf("unexpected batch: %d %v %+v", n, err, batch)
+	}
+	n, err = r.ReadListmode(batch)
+	if err != nil || n != 1 || batch[0].Channel != 4 {
+		t.Fatalf("unexpected batch: %d %v %+v", n, err, batch)
+	}
+	n, err = r.ReadListmode(batch)
+	if err != io.EOF || n != 0 {
+		t.Fatalf("expected io.EOF, got %d %v", n, err)
+	}
+
+	var typeErr *DataTypeError
+	if _, err = r.NextMich(); !errors.As(err, &typeErr) {
+		t.Fatalf("expected DataTypeError, got %v", err)
+	}
+}
+
+func TestReaderPartialRecord(t *testing.T) {
+	data := testListmodeData(2)
+	r, err := NewReader(bytes.NewReader(testFile(ListmodeDataType, data[:len(data)-1])))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = r.NextListmode(); err != nil {
+		t.Fatal(err)
+	}
+	var partialErr *PartialRecordError
+	if _, err = r.NextListmode(); !errors.As(err, &partialErr) {
+		t.Fatalf("expected PartialRecordError, got %v", err)
+	}
+}
@@ -0,0 +1,300 @@
+package dpetk
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+)
+
+func WriteFile(path string, dataSet *DataSet, opts ...WriteOption) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = Write(dataSet, file, opts...)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// Write 按930文件格式写出dataset，文件头各字段默认按原值写出，可通过UpdateChecksum重新计算长度及校验值。
+// dataset中的DataBuf不为空时原样写出数据区，否则根据文件类型写出对应的数据。
+// 文件类型所需的信息区为nil时按全零写出。
+// 默认的StringTrimFirstBlank解析方式会丢弃字符串字段的填充字节，需要逐字节还原文件头时应以StringRaw方式解析
+func Write(dataSet *DataSet, writer io.Writer, opts ...WriteOption) error {
+	option := genWriteOption(opts...)
+	if option.updateChecksum {
+		return writeWithChecksum(dataSet, writer)
+	}
+	bw := bufio.NewWriter(writer)
+	w := newWriter(bw)
+	w.writeHeader(dataSet)
+	w.writeData(dataSet)
+	if w.err != nil {
+		return w.err
+	}
+	return bw.Flush()
+}
+
+// writeWithChecksum 先写出数据区计算其长度及CRC，回填到DataInfo后写出文件头，最后计算并回填HeaderCRC
+func writeWithChecksum(dataSet *DataSet, writer io.Writer) error {
+	data := bytes.NewBuffer(nil)
+	w := newWriter(data)
+	w.writeData(dataSet)
+	if w.err != nil {
+		return w.err
+	}
+	if int64(data.Len()) > math.MaxUint32 {
+		return fmt.Errorf("data area of %d bytes exceeds DataLength range", data.Len())
+	}
+
+	info := DataInfo{}
+	if dataSet.DataInfo != nil {
+		info = *dataSet.DataInfo
+	}
+	info.DataLength = uint32(data.Len())
+	info.CRC = CRC16(data.Bytes())
+	updated := *dataSet
+	updated.DataInfo = &info
+
+	head := bytes.NewBuffer(nil)
+	w = newWriter(head)
+	w.writeHeader(&updated)
+	if w.err != nil {
+		return w.err
+	}
+	bs := head.Bytes()
+	w.byteOrder.PutUint16(bs[headerCRCSkip-2:], CRC16(bs[headerCRCSkip:]))
+	if _, err := writer.Write(bs); err != nil {
+		return err
+	}
+	_, err := data.WriteTo(writer)
+	return err
+}
+
+type Writer struct {
+	writer    io.Writer
+	byteOrder binary.ByteOrder
+
+	// 写出过程中遇到的第一个错误，出错后的写出均被忽略
+	err error
+
+	// 由PublicInfo中的软件版本确定的文件头布局
+	layout *Layout
+}
+
+func newWriter(writer io.Writer) *Writer {
+	return &Writer{
+		writer:    writer,
+		byteOrder: binary.LittleEndian,
+	}
+}
+
+// writeHeader 写出数据区之前的各个信息区
+func (w *Writer) writeHeader(dataSet *DataSet) {
+	publicInfo := dataSet.PublicInfo
+	if publicInfo == nil {
+		publicInfo = &PublicInfo{}
+	}
+	w.writePublicInfo(publicInfo)
+	w.layout = layoutFor(publicInfo.SoftwareVersion)
+	w.writeDeviceInfo(dataSet.DeviceInfo)
+	switch publicInfo.Type {
+	case RawDataType, ListmodeDataType, MichDataType:
+		w.writeAcquisitionInfo(dataSet.AcquisitionInfo)
+		w.writeDataInfo(dataSet.DataInfo)
+	case EnergyCalibrationMap, TimeCalibrationMap, EnergySpectrumData:
+		w.writeDataInfo(dataSet.DataInfo)
+	default:
+		w.writeAcquisitionInfo(dataSet.AcquisitionInfo)
+		w.writeImageInfo(dataSet.ImageInfo)
+		w.writeDataInfo(dataSet.DataInfo)
+	}
+}
+
+// writeData 写出数据区
+func (w *Writer) writeData(dataSet *DataSet) {
+	if dataSet.DataBuf != nil {
+		w.writeBytes(dataSet.DataBuf.Bytes())
+		return
+	}
+	var fileType uint16
+	if dataSet.PublicInfo != nil {
+		fileType = dataSet.PublicInfo.Type
+	}
+	switch fileType {
+	case RawDataType:
+		w.writeRawData(dataSet.RawData)
+	case ListmodeDataType:
+		w.writeListmodeData(dataSet.ListmodeData)
+	case MichDataType:
+		w.writeMichData(dataSet.MichData)
+	case EnergyCalibrationMap:
+		w.writeCalibrationMap(dataSet.EnergyCalibration)
+	case TimeCalibrationMap:
+		w.writeCalibrationMap(dataSet.TimeCalibration)
+	case EnergySpectrumData:
+		w.writeEnergySpectrum(dataSet.EnergySpectrum)
+	default:
+		w.writeFloat32Slice(dataSet.ImageData, len(dataSet.ImageData))
+	}
+}
+
+func (w *Writer) writePublicInfo(info *PublicInfo) {
+	magicKey := info.MagicKey
+	if magicKey == nil {
+		magicKey = MagicKey[:]
+	}
+	if w.err == nil && len(magicKey) != len(MagicKey) {
+		w.err = fmt.Errorf("magic key must be %d bytes, got %d", len(MagicKey), len(magicKey))
+	}
+	w.writeBytes(magicKey)
+	w.writeUint16(info.HeaderCRC)
+	w.writeUint32(info.Length)
+	w.writeUint16(info.Type)
+	w.writeString(info.SoftwareVersion, 16)
+	w.writeUint32(info.HeaderLength)
+}
+
+func (w *Writer) writeDeviceInfo(info *DeviceInfo) {
+	if info == nil {
+		info = &DeviceInfo{}
+	}
+	w.writeFields(w.layout.DeviceInfo, info)
+}
+
+func (w *Writer) writeAcquisitionInfo(info *AcquisitionInfo) {
+	if info == nil {
+		info = &AcquisitionInfo{}
+	}
+	w.writeFields(w.layout.AcquisitionInfo, info)
+}
+
+func (w *Writer) writeImageInfo(info *ImageInfo) {
+	if info == nil {
+		info = &ImageInfo{}
+	}
+	w.writeFields(w.layout.ImageInfo, info)
+}
+
+func (w *Writer) writeDataInfo(info *DataInfo) {
+	if info == nil {
+		info = &DataInfo{}
+	}
+	w.writeFields(w.layout.DataInfo, info)
+}
+
+func (w *Writer) writeRawData(data []RawDataItem) {
+	for _, item := range data {
+		if w.err == nil && len(item.Data) != rawDataPacketLen {
+			w.err = fmt.Errorf("raw data packet must be %d bytes, got %d", rawDataPacketLen, len(item.Data))
+		}
+		w.writeBytes(item.Data)
+		w.writeUint16(uint16(item.IP))
+	}
+}
+
+func (w *Writer) writeListmodeData(data []ListmodeDataItem) {
+	for _, item := range data {
+		ch := uint16(item.Reserved&(1<<3-1))<<12 | item.Channel&(1<<12-1)
+		if item.XTalk {
+			ch |= 1 << 15
+		}
+		w.writeUint16(uint16(item.IP))
+		w.writeUint16(ch)
+		w.writeFloat32(item.Energy)
+		w.writeFloat64(item.Time)
+	}
+}
+
+func (w *Writer) writeMichData(data []uint16) {
+	for _, v := range data {
+		w.writeUint16(v)
+	}
+}
+
+func (w *Writer) writeCalibrationMap(data *CalibrationMap) {
+	if data == nil {
+		return
+	}
+	w.writeFloat32Slice(data.Values, len(data.Values))
+}
+
+func (w *Writer) writeEnergySpectrum(data *EnergySpectrum) {
+	if data == nil {
+		return
+	}
+	w.writeUint32Slice(data.Counts, len(data.Counts))
+}
+
+func (w *Writer) writeBytes(bs []byte) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = w.writer.Write(bs)
+}
+
+func (w *Writer) writeUint16(v uint16) {
+	var bs [2]byte
+	w.byteOrder.PutUint16(bs[:], v)
+	w.writeBytes(bs[:])
+}
+
+func (w *Writer) writeUint32(v uint32) {
+	var bs [4]byte
+	w.byteOrder.PutUint32(bs[:], v)
+	w.writeBytes(bs[:])
+}
+
+func (w *Writer) writeFloat32(v float32) {
+	w.writeUint32(math.Float32bits(v))
+}
+
+func (w *Writer) writeFloat64(v float64) {
+	var bs [8]byte
+	w.byteOrder.PutUint64(bs[:], math.Float64bits(v))
+	w.writeBytes(bs[:])
+}
+
+// writeString 写出定长字符串，不足部分以空字符填充
+func (w *Writer) writeString(s string, l int) {
+	if w.err == nil && len(s) > l {
+		w.err = fmt.Errorf("string %q exceeds field length %d", s, l)
+	}
+	bs := make([]byte, l)
+	copy(bs, s)
+	w.writeBytes(bs)
+}
+
+// writeFloat32Slice 写出定长float32数组，数组为nil时按全零写出
+func (w *Writer) writeFloat32Slice(v []float32, l int) {
+	if w.err == nil && v != nil && len(v) != l {
+		w.err = fmt.Errorf("float32 slice must have %d elements, got %d", l, len(v))
+	}
+	for i := 0; i < l; i++ {
+		if i < len(v) {
+			w.writeFloat32(v[i])
+		} else {
+			w.writeFloat32(0)
+		}
+	}
+}
+
+// writeUint32Slice 写出定长uint32数组，数组为nil时按全零写出
+func (w *Writer) writeUint32Slice(v []uint32, l int) {
+	if w.err == nil && v != nil && len(v) != l {
+		w.err = fmt.Errorf("uint32 slice must have %d elements, got %d", l, len(v))
+	}
+	for i := 0; i < l; i++ {
+		if i < len(v) {
+			w.writeUint32(v[i])
+		} else {
+			w.writeUint32(0)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package dpetk
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func testDataSet(fileType uint16) *DataSet {
+	return &DataSet{
+		PublicInfo: &PublicInfo{
+			MagicKey:        append([]uint8(nil), MagicKey[:]...),
+			HeaderCRC:       0x1234,
+			Length:          44,
+			Type:            fileType,
+			SoftwareVersion: "V1.0.2",
+			HeaderLength:    rawHeaderLen,
+		},
+		DeviceInfo: &DeviceInfo{
+			Length:            96,
+			Device:            "DigitMI-930",
+			Serial:            "SN0001",
+			AxisDetectors:     4,
+			TransDetectors:    60,
+			DetectorsRings:    8,
+			DetectorsChannels: 64,
+			IpCounts:          30,
+			IpStart:           0x0101,
+			ChannelCounts:     128,
+			ChannelStart:      0,
+			MvtThresholds:     []float32{1, 2, 3, 4, 5, 6, 7, 8},
+			MvtParameters:     []float32{0.1, 0.2, 0.3},
+		},
+		AcquisitionInfo: &AcquisitionInfo{
+			Length:             360,
+			Isotope:            18,
+			Activity:           10.5,
+			InjectTime:         "20221001120000",
+			Time:               "20221001130000",
+			Duration:           600,
+			TimeWindow:         4,
+			DelayWindow:        100,
+			XTalkWindow:        2,
+			EnergyWindow:       []uint32{350, 650},
+			PositionWindow:     1,
+			Corrected:          1,
+			TablePosition:      12.5,
+			TableHeight:        80,
+			PETCTSpacing:       700,
+			TableCount:         2,
+			TableIndex:         1,
+			ScanLengthPerTable: 260,
+			PatientID:          "P0001",
+			StudyID:            "S0001",
+			PatientName:        "Zhang San",
+			PatientSex:         "M",
+			PatientHeight:      175,
+			PatientWeight:      70,
+		},
+		DataInfo: &DataInfo{
+			Length:     10,
+			DataLength: 0,
+			CRC:        0x5678,
+		},
+	}
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	raw := testDataSet(RawDataType)
+	packet := make([]uint8, rawDataPacketLen)
+	for i := range packet {
+		packet[i] = uint8(i)
+	}
+	raw.RawData = []RawDataItem{{Data: packet, IP: 0x0102}, {Data: packet, IP: 0xff00}}
+
+	listmode := testDataSet(ListmodeDataType)
+	listmode.ListmodeData = []ListmodeDataItem{
+		{IP: 0x0102, XTalk: true, Reserved: 5, Channel: 4095, Energy: 511.5, Time: 123456.789},
+		{IP: 0x0304, Channel: 1, Energy: 300, Time: 1},
+	}
+
+	mich := testDataSet(MichDataType)
+	mich.MichData = []uint16{0, 1, 65535}
+
+	for _, dataSet := range []*DataSet{raw, listmode, mich, testImageDataSet()} {
+		buf := bytes.NewBuffer(nil)
+		if err := Write(dataSet, buf); err != nil {
+			t.Fatal(err)
+		}
+		encoded := append([]byte(nil), buf.Bytes()...)
+		parsed, err := Parse(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(dataSet, parsed) {
+			t.Fatalf("round trip mismatch:\n%+v\n%+v", dataSet, parsed)
+		}
+
+		// 重新写出的文件应与原文件逐字节一致
+		again := bytes.NewBuffer(nil)
+		if err = Write(parsed, again); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(encoded, again.Bytes()) {
+			t.Fatalf("rewritten file differs for type %d", dataSet.PublicInfo.Type)
+		}
+	}
+}
+
+func TestWriteRawHeader(t *testing.T) {
+	input := testFile(MichDataType, []byte{1, 0, 2, 0})
+	copy(input, "vendor magic key")
+	copy(input[24:40], "V1.0.2          ")
+	copy(input[48:64], "DigitMI-930\x00    ")
+	dataSet, err := Parse(bytes.NewReader(input), WithStringMode(StringRaw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := bytes.NewBuffer(nil)
+	if err = Write(dataSet, buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(input, buf.Bytes()) {
+		t.Fatal("rewritten file differs from input")
+	}
+
+	// 未设置魔数时写出默认值
+	dataSet.PublicInfo.MagicKey = nil
+	buf.Reset()
+	if err = Write(dataSet, buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), MagicKey[:]) {
+		t.Fatal("expected default magic key")
+	}
+	dataSet.PublicInfo.MagicKey = []uint8("short")
+	if err = Write(dataSet, bytes.NewBuffer(nil)); err == nil {
+		t.Fatal("expected magic key length error")
+	}
+}
+
+func TestWriteUnparsedData(t *testing.T) {
+	input := testFile(ListmodeDataType, testListmodeData(3))
+	dataSet, err := Parse(bytes.NewReader(input), NotParseData())
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := bytes.NewBuffer(nil)
+	if err = Write(dataSet, buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(input, buf.Bytes()) {
+		t.Fatal("rewritten file differs from input")
+	}
+}
+
+func TestWriteFieldTooLong(t *testing.T) {
+	dataSet := testDataSet(MichDataType)
+	dataSet.DeviceInfo.Device = "a device name longer than sixteen bytes"
+	if err := Write(dataSet, bytes.NewBuffer(nil)); err == nil {
+		t.Fatal("expected error for oversized string field")
+	}
+}
@@ -5,5 +5,5 @@ import (
 )
 
 func ParseFile930(path string) (*dpetk.DataSet, error) {
-	return dpetk.ParseFile(path, true)
+	return dpetk.ParseFile(path)
 }